@@ -0,0 +1,21 @@
+package battle
+
+import "neonsigil/internal/entity"
+
+// UpdateAuras recomputes the aura buffs on every live enemy from the carriers
+// currently alive on the board. Buffs only last while the carrier is in range.
+func (b *BattleState) UpdateAuras() {
+	for _, e := range b.Enemies {
+		e.Buff = entity.AuraBuff{}
+	}
+	for _, carrier := range b.Enemies {
+		if !carrier.IsAuraCarrier() || !carrier.Alive || carrier.Reached {
+			continue
+		}
+		for _, e := range b.Enemies {
+			if e.Alive && !e.Reached && carrier.AuraCovers(e) {
+				e.ApplyAura(carrier.Def.Aura)
+			}
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package battle
+
+import (
+	"neonsigil/internal/config"
+	"neonsigil/internal/data"
+	"neonsigil/internal/entity"
+)
+
+// Barrier returns the stage's barrier definition, or nil if the stage has none
+func (b *BattleState) Barrier() *data.BarrierDef {
+	if !b.Stage.NodesEnabled {
+		return nil
+	}
+	return data.BarrierDefs[b.Stage.BarrierEffect]
+}
+
+// NodesRequired returns how many occupied nodes the barrier needs
+func (b *BattleState) NodesRequired() int {
+	def := b.Barrier()
+	if def != nil && def.NodesRequired > 0 {
+		return def.NodesRequired
+	}
+	return len(b.Board.NodeSet)
+}
+
+// NodeNetworkComplete reports whether enough nodes are occupied to power the barrier
+func (b *BattleState) NodeNetworkComplete() bool {
+	need := b.NodesRequired()
+	return need > 0 && len(b.GetOccupiedNodes()) >= need
+}
+
+// UpdateBarrier ticks barrier timers and charges the barrier while the node
+// network is complete and the cooldown has elapsed
+func (b *BattleState) UpdateBarrier() {
+	if b.BarrierActive > 0 {
+		b.BarrierActive -= 1.0 / 60.0
+	}
+	if b.BarrierCooldown > 0 {
+		b.BarrierCooldown -= 1.0 / 60.0
+		return
+	}
+
+	def := b.Barrier()
+	if def == nil || b.BarrierCharge >= 1 || !b.NodeNetworkComplete() {
+		return
+	}
+	if def.ChargeTime <= 0 {
+		b.BarrierCharge = 1
+		return
+	}
+	b.BarrierCharge = min(1, b.BarrierCharge+1.0/60.0/def.ChargeTime)
+}
+
+// BarrierReady reports whether the barrier is charged and the network is still complete
+func (b *BattleState) BarrierReady() bool {
+	return b.Barrier() != nil && b.BarrierCooldown <= 0 && b.BarrierCharge >= 1 && b.NodeNetworkComplete()
+}
+
+// TriggerBarrier is the player's activation: global barriers fire at once,
+// targeted barriers wait for the player to click an area on the board
+func (b *BattleState) TriggerBarrier() {
+	if !b.BarrierReady() {
+		return
+	}
+	if b.Barrier().Radius > 0 {
+		b.BarrierAiming = !b.BarrierAiming
+		return
+	}
+	b.ActivateBarrier()
+}
+
+// ActivateBarrierAt fires a targeted barrier centered on the given screen position
+func (b *BattleState) ActivateBarrierAt(center config.FPos) {
+	b.BarrierTarget = center
+	b.fireBarrier(&center)
+}
+
+// NodeFactions returns the factions of units standing on occupied nodes
+func (b *BattleState) NodeFactions() map[config.Faction]bool {
+	factions := make(map[config.Faction]bool)
+	for _, node := range b.GetOccupiedNodes() {
+		for _, u := range b.Units {
+			if u.Deployed && u.GridX == node.X && u.GridY == node.Y {
+				factions[u.Def.Faction] = true
+			}
+		}
+	}
+	return factions
+}
+
+// ActivateBarrier fires the stage barrier over the whole board
+func (b *BattleState) ActivateBarrier() {
+	b.fireBarrier(nil)
+}
+
+// fireBarrier applies every effect whose faction requirement is met by the
+// units on the nodes, limited to the def's radius around center when given
+func (b *BattleState) fireBarrier(center *config.FPos) {
+	def := b.Barrier()
+	if def == nil || !b.BarrierReady() {
+		return
+	}
+	b.BarrierCooldown = def.Cooldown
+	b.BarrierActive = def.Duration
+	b.BarrierCharge = 0
+
+	radius := def.Radius * float64(config.TileSize)
+	factions := b.NodeFactions()
+	for _, eff := range def.Effects {
+		if eff.Faction != "" && !factions[eff.Faction] {
+			continue
+		}
+		if eff.Kind == config.BarrierZone {
+			b.barrierZones(eff, center, radius)
+			continue
+		}
+		for _, e := range b.Enemies {
+			if !e.Alive || e.Reached || !barrierTargets(eff.Target, e) {
+				continue
+			}
+			if center != nil && def.Radius > 0 {
+				dx := e.Pos.X - center.X
+				dy := e.Pos.Y - center.Y
+				if dx*dx+dy*dy > radius*radius {
+					continue
+				}
+			}
+			applyBarrierEffect(eff, e)
+		}
+	}
+}
+
+// barrierZones leaves the effect's ground zone on the targeted tile, or on
+// every path tile holding an affected enemy when the barrier is untargeted
+func (b *BattleState) barrierZones(eff data.BarrierEffect, center *config.FPos, radius float64) {
+	zd := data.ZoneDefs[eff.Zone]
+	if zd == nil {
+		return
+	}
+	if center != nil {
+		gx, gy := b.Board.ScreenToGrid(int(center.X), int(center.Y))
+		b.AddZone(zd, config.Pos{X: gx, Y: gy}, nil)
+		return
+	}
+	seen := make(map[config.Pos]bool)
+	for _, e := range b.Enemies {
+		if !e.Alive || e.Reached || !barrierTargets(eff.Target, e) {
+			continue
+		}
+		gx, gy := b.Board.ScreenToGrid(int(e.Pos.X), int(e.Pos.Y))
+		tile := config.Pos{X: gx, Y: gy}
+		if !seen[tile] {
+			seen[tile] = true
+			b.AddZone(zd, tile, nil)
+		}
+	}
+}
+
+func barrierTargets(t config.BarrierTarget, e *entity.Enemy) bool {
+	switch t {
+	case config.BarrierTargetGround:
+		return !e.IsAir()
+	case config.BarrierTargetAir:
+		return e.IsAir()
+	case config.BarrierTargetStealth:
+		return e.Def.Stealth
+	}
+	return true
+}
+
+func applyBarrierEffect(eff data.BarrierEffect, e *entity.Enemy) {
+	switch eff.Kind {
+	case config.BarrierSlow:
+		e.SlowTimer = max(e.SlowTimer, eff.Duration)
+	case config.BarrierMark:
+		e.Mark(eff.Value, eff.Duration)
+	case config.BarrierDamage:
+		e.TakeDamage(e.MaxHP*eff.Value, config.DamageMagic)
+	case config.BarrierReveal:
+		e.Reveal(eff.Duration)
+	case config.BarrierStun:
+		e.Stun(eff.Duration)
+	}
+}
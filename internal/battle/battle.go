@@ -9,6 +9,7 @@ import (
 	"neonsigil/internal/board"
 	"neonsigil/internal/config"
 	"neonsigil/internal/data"
+	"neonsigil/internal/economy"
 	"neonsigil/internal/entity"
 	"neonsigil/internal/shop"
 	"neonsigil/internal/ui"
@@ -20,16 +21,27 @@ type BattleState struct {
 	Stage        *data.StageDef
 	Board        *board.Board
 	Shop         *shop.Shop
+	Economy      *economy.Economy
 	WaveMgr      *wave.WaveManager
 	Enemies      []*entity.Enemy
 	Units        []*entity.Unit
 	Projectiles  []*entity.Projectile
+	Zones        []*entity.Zone
+	Summons      []*entity.Summon
+	Traps        []*entity.Trap
 	Integrity    int
 	MaxIntegrity int
 	Phase        config.BattlePhase
 	Tick         int
 	Rng          *rand.Rand
 
+	// Route choices at path forks, drawn from their own seeded RNG so shop
+	// rolls never change them
+	RouteSeed int64
+	RouteRng  *rand.Rand
+	RouteLog  []RouteChoice // every branch taken this battle
+	forkTurns map[string]int
+
 	// UI state
 	SelectedUnit  *entity.Unit
 	DraggingUnit  *entity.Unit
@@ -37,12 +49,28 @@ type BattleState struct {
 	DragFromGrid  bool
 	DragOrigX     int
 	DragOrigY     int
+	CursorX       int
+	CursorY       int
+	SelectedItem  int           // inventory index, -1 = none
+	ShowPool      bool          // pool viewer open
+	ShowLedger    bool          // gold ledger panel open
+	PlacingTrap   *data.TrapDef // bought trap waiting for a path tile, nil = none
+	MazeDirty     bool          // units moved since the maze was last routed
+
+	Formation *Formation // saved with F5 for this battle, nil = none
 
 	// Buttons
 	BtnStartWave ui.Button
 	BtnReroll    ui.Button
-	BtnLevelUp   ui.Button
+	BtnBuyXP     ui.Button
 	BtnSell      ui.Button
+	BtnBarrier   ui.Button
+	BtnBuyItem   ui.Button
+	BtnPool      ui.Button
+	BtnLock      ui.Button
+	BtnLedger    ui.Button
+	BtnTargeting ui.Button
+	BtnTraps     []ui.Button // one per data.TrapDefs entry
 
 	// Result
 	Victory  bool
@@ -51,10 +79,21 @@ type BattleState struct {
 	// Barrier
 	BarrierCooldown float64
 	BarrierActive   float64
+	BarrierCharge   float64                // 0..1, fills while the node network is complete
+	BarrierAiming   bool                   // waiting for the player to pick a target area
+	BarrierTarget   config.FPos            // center of the last targeted activation
+	DisabledNodes   map[config.Pos]float64 // node -> seconds left switched off
+
+	// Items
+	Inventory []*data.ItemDef
+
+	// Economy
+	WaveLeaks int // enemies leaked during the current wave
 
 	// Stats
-	KillCount int
-	WaveTime  float64
+	RetiredStats entity.CombatStats // damage stats of sold and fused-away units
+	KillCount    int
+	WaveTime     float64
 }
 
 // NewBattleState creates a new battle state for the given stage
@@ -63,11 +102,13 @@ func NewBattleState(stage *data.StageDef) *BattleState {
 	b := board.NewBoard(stage)
 	s := shop.NewShop(stage, rng)
 	waveMgr := wave.NewWaveManager(stage, b)
+	routeSeed := rand.Int63()
 
 	return &BattleState{
 		Stage:        stage,
 		Board:        b,
 		Shop:         s,
+		Economy:      economy.NewEconomy(stage),
 		WaveMgr:      waveMgr,
 		Enemies:      make([]*entity.Enemy, 0),
 		Units:        make([]*entity.Unit, 0),
@@ -76,12 +117,20 @@ func NewBattleState(stage *data.StageDef) *BattleState {
 		MaxIntegrity: stage.Integrity,
 		Phase:        config.PhasePrepare,
 		Rng:          rng,
+		RouteSeed:    routeSeed,
+		RouteRng:     rand.New(rand.NewSource(routeSeed)),
+		forkTurns:    make(map[string]int),
+		SelectedItem: -1,
+		BtnTraps:     make([]ui.Button, len(data.TrapDefs)),
+
+		DisabledNodes: make(map[config.Pos]float64),
 	}
 }
 
 // Update runs one frame of battle logic
 func (b *BattleState) Update() {
 	b.Tick++
+	b.Shop.Ledger.Tick = b.Tick
 
 	// Handle input
 	b.handleInput()
@@ -90,6 +139,9 @@ func (b *BattleState) Update() {
 		return
 	}
 
+	// Maze stages reroute around units that moved
+	b.UpdateMaze()
+
 	// Update wave spawning
 	if b.WaveMgr.WaveActive {
 		b.WaveTime += 1.0 / 60.0
@@ -97,11 +149,21 @@ func (b *BattleState) Update() {
 		b.Enemies = append(b.Enemies, newEnemies...)
 	}
 
+	// Special tiles: unit bonuses and area effects
+	b.UpdateSpecials()
+
+	// Totem auras buff nearby enemies
+	b.UpdateAuras()
+
+	// Ground zones slow, weaken and burn enemies standing in them
+	b.UpdateZones()
+
 	// Update enemies
 	for _, e := range b.Enemies {
 		e.Update(b.Board)
 		if e.Reached && e.Alive {
 			b.Integrity -= e.Def.LeakDamage
+			b.WaveLeaks++
 			e.Alive = false
 			if b.Integrity <= 0 {
 				b.Integrity = 0
@@ -111,13 +173,29 @@ func (b *BattleState) Update() {
 		}
 	}
 
+	// Enemies at a path fork pick their branch
+	b.resolveForks()
+
+	// Hacker pulses disrupt nearby units
+	b.UpdateDisruption()
+
+	// Recompute stealth visibility before units pick targets
+	b.UpdateDetection()
+
 	// Update units (combat)
 	for _, u := range b.Units {
 		u.Update(b.Enemies, b.Board, &b.Projectiles)
 	}
 
+	// Drones, blocks and turrets summoned by unit skills
+	b.UpdateSummons()
+
+	// Path traps
+	b.UpdateTraps()
+
 	// Update projectiles
 	entity.UpdateProjectiles(b.Projectiles, b.Enemies)
+	b.spawnProjectileZones()
 
 	// Clean up dead projectiles
 	alive := make([]*entity.Projectile, 0, len(b.Projectiles))
@@ -128,37 +206,35 @@ func (b *BattleState) Update() {
 	}
 	b.Projectiles = alive
 
+	// Boss phase scripts
+	b.UpdateBosses()
+
 	// Count kills and award gold
 	for _, e := range b.Enemies {
 		if !e.Alive && !e.Reached && e.HP <= 0 {
 			// Mark as processed
 			e.Reached = true // reuse flag to prevent double-counting
 			b.KillCount++
-			b.Shop.AddGold(1) // 1 gold per kill
+			b.Shop.AddGold(b.Economy.KillGold(), config.GoldKill)
 		}
 	}
 
-	// Update barrier
-	if b.BarrierActive > 0 {
-		b.BarrierActive -= 1.0 / 60.0
-	}
-	if b.BarrierCooldown > 0 {
-		b.BarrierCooldown -= 1.0 / 60.0
-	}
-
-	// Check barrier activation
-	if b.Stage.NodesEnabled && b.BarrierCooldown <= 0 {
-		occupied := b.GetOccupiedNodes()
-		if len(occupied) >= 3 {
-			b.ActivateBarrier()
-		}
-	}
+	// Update barrier charge and timers
+	b.UpdateBarrier()
 
-	// Check wave end — give bonus gold and refresh shop
+	// Check wave end — pay wave income and refresh shop
 	if !b.WaveMgr.WaveActive && b.Phase == config.PhaseWave {
 		b.Phase = config.PhasePrepare
-		b.Shop.AddGold(3 + b.WaveMgr.CurrentWave) // wave bonus
+		income := b.Economy.EndWave(b.WaveMgr.CurrentWave, b.WaveLeaks)
+		b.Shop.AddGold(income.Base, config.GoldWave)
+		b.Shop.AddGold(income.Interest, config.GoldInterest)
+		b.Shop.AddGold(income.Streak, config.GoldStreak)
+		b.Shop.AddGold(income.Reward, config.GoldWave)
+		b.Shop.Ledger.Wave = b.WaveMgr.CurrentWave + 1
+		b.Shop.GrantWaveXP()
 		b.Shop.Refresh()
+		b.dropComponent()
+		b.clearSummons(nil)
 	}
 
 	// Check victory
@@ -179,27 +255,103 @@ func (b *BattleState) Update() {
 
 func (b *BattleState) handleInput() {
 	mx, my := ebiten.CursorPosition()
+	b.CursorX, b.CursorY = mx, my
 
 	// Update button hover states
 	b.BtnStartWave.Hovered = b.BtnStartWave.Contains(mx, my)
 	b.BtnReroll.Hovered = b.BtnReroll.Contains(mx, my)
-	b.BtnLevelUp.Hovered = b.BtnLevelUp.Contains(mx, my)
+	b.BtnBuyXP.Hovered = b.BtnBuyXP.Contains(mx, my)
 	b.BtnSell.Hovered = b.BtnSell.Contains(mx, my)
+	b.BtnBarrier.Hovered = b.BtnBarrier.Contains(mx, my)
+	b.BtnBuyItem.Hovered = b.BtnBuyItem.Contains(mx, my)
+	b.BtnPool.Hovered = b.BtnPool.Contains(mx, my)
+	b.BtnLock.Hovered = b.BtnLock.Contains(mx, my)
+	b.BtnLedger.Hovered = b.BtnLedger.Contains(mx, my)
+	b.BtnTargeting.Hovered = b.SelectedUnit != nil && b.BtnTargeting.Contains(mx, my)
+	for i := range b.BtnTraps {
+		b.BtnTraps[i].Hovered = b.BtnTraps[i].Contains(mx, my)
+	}
+
+	// Barrier hotkey
+	if inpututil.IsKeyJustPressed(ebiten.KeyB) {
+		b.TriggerBarrier()
+	}
+
+	// Shop lock hotkey
+	if inpututil.IsKeyJustPressed(ebiten.KeyL) {
+		b.Shop.ToggleLock()
+	}
+
+	// Pool viewer hotkey
+	if inpututil.IsKeyJustPressed(ebiten.KeyP) {
+		b.togglePanel(&b.ShowPool)
+	}
+
+	// Formation hotkeys (preparation only)
+	if b.Phase == config.PhasePrepare {
+		if inpututil.IsKeyJustPressed(ebiten.KeyF5) {
+			b.SaveFormation()
+		}
+		if inpututil.IsKeyJustPressed(ebiten.KeyF9) {
+			b.LoadFormation()
+		}
+	}
+
+	// Gold ledger hotkey
+	if inpututil.IsKeyJustPressed(ebiten.KeyG) {
+		b.togglePanel(&b.ShowLedger)
+	}
 
 	if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
 		b.handleClick(mx, my)
 	}
 
-	// Right click to deselect
+	// Right click on a shop slot freezes it; elsewhere it deselects
+	// (or cancels barrier aiming)
 	if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonRight) {
+		if slot := shopSlotAt(mx, my); slot >= 0 {
+			b.Shop.ToggleFreeze(slot)
+			return
+		}
 		b.SelectedUnit = nil
 		b.DraggingUnit = nil
+		b.BarrierAiming = false
+		b.SelectedItem = -1
+		b.PlacingTrap = nil
 	}
 }
 
 func (b *BattleState) handleClick(mx, my int) {
+	// Targeted barrier: the next board click picks the area, anything else cancels
+	if b.BarrierAiming {
+		b.BarrierAiming = false
+		gx, gy := b.Board.ScreenToGrid(mx, my)
+		if mx >= config.BoardOffsetX && my >= config.BoardOffsetY && gx < config.BoardCols && gy < config.BoardRows {
+			b.ActivateBarrierAt(config.FPos{X: float64(mx), Y: float64(my)})
+		}
+		return
+	}
+
+	// Trap placement: the next path tile click places it, anything else cancels
+	if b.PlacingTrap != nil {
+		for i := range b.BtnTraps {
+			if b.BtnTraps[i].Contains(mx, my) {
+				b.SelectTrap(data.TrapDefs[i])
+				return
+			}
+		}
+		gx, gy := b.Board.ScreenToGrid(mx, my)
+		if mx >= config.BoardOffsetX && my >= config.BoardOffsetY {
+			b.PlaceTrap(gx, gy)
+		}
+		b.PlacingTrap = nil
+		return
+	}
+
 	// Check buttons first
 	if b.BtnStartWave.Contains(mx, my) && !b.BtnStartWave.Disabled && !b.WaveMgr.WaveActive {
+		b.Economy.StartWave(b.Shop.Gold)
+		b.WaveLeaks = 0
 		b.WaveMgr.StartWave()
 		b.Phase = config.PhaseWave
 		return
@@ -210,8 +362,13 @@ func (b *BattleState) handleClick(mx, my int) {
 		return
 	}
 
-	if b.BtnLevelUp.Contains(mx, my) && !b.BtnLevelUp.Disabled {
-		b.Shop.LevelUp()
+	if b.BtnBuyXP.Contains(mx, my) && !b.BtnBuyXP.Disabled {
+		b.Shop.BuyXP()
+		return
+	}
+
+	if b.BtnBarrier.Contains(mx, my) && !b.BtnBarrier.Disabled && b.Barrier() != nil {
+		b.TriggerBarrier()
 		return
 	}
 
@@ -220,20 +377,60 @@ func (b *BattleState) handleClick(mx, my int) {
 		return
 	}
 
-	// Check shop slot clicks
-	shopY := float64(config.ScreenHeight - 110)
-	if float64(my) >= shopY && float64(my) < shopY+62 {
-		for i := 0; i < config.ShopSlots; i++ {
-			slotX := 80 + i*150
-			if mx >= slotX && mx < slotX+140 {
-				if b.Shop.CanBuy(i) {
-					b.BuyUnit(i)
+	if b.SelectedUnit != nil && b.BtnTargeting.Contains(mx, my) {
+		b.SelectedUnit.CycleTargeting()
+		return
+	}
+
+	if b.BtnLock.Contains(mx, my) {
+		b.Shop.ToggleLock()
+		return
+	}
+
+	if b.BtnPool.Contains(mx, my) {
+		b.togglePanel(&b.ShowPool)
+		return
+	}
+
+	if b.BtnLedger.Contains(mx, my) {
+		b.togglePanel(&b.ShowLedger)
+		return
+	}
+
+	for i := range b.BtnTraps {
+		if b.BtnTraps[i].Contains(mx, my) && !b.BtnTraps[i].Disabled {
+			b.SelectTrap(data.TrapDefs[i])
+			return
+		}
+	}
+
+	if b.BtnBuyItem.Contains(mx, my) && !b.BtnBuyItem.Disabled && b.ItemsEnabled() {
+		b.BuyComponent()
+		return
+	}
+
+	// Check inventory clicks
+	if b.ItemsEnabled() {
+		iy := config.BenchSlotY()
+		if my >= iy && my < iy+inventorySlotSize {
+			for i := 0; i < b.inventoryLen(); i++ {
+				ix := inventorySlotX(i)
+				if mx >= ix && mx < ix+inventorySlotSize {
+					b.ClickItem(i)
+					return
 				}
-				return
 			}
 		}
 	}
 
+	// Check shop slot clicks
+	if slot := shopSlotAt(mx, my); slot >= 0 {
+		if b.Shop.CanBuy(slot) {
+			b.BuyUnit(slot)
+		}
+		return
+	}
+
 	// Check bench clicks
 	bY := config.BenchSlotY()
 	if my >= bY && my < bY+50 {
@@ -243,6 +440,9 @@ func (b *BattleState) handleClick(mx, my int) {
 				// Select bench unit
 				for _, u := range b.Units {
 					if u.BenchSlot == i {
+						if b.EquipSelectedItem(u) {
+							return
+						}
 						if b.SelectedUnit == u {
 							// Double click = deselect
 							b.SelectedUnit = nil
@@ -256,6 +456,7 @@ func (b *BattleState) handleClick(mx, my int) {
 				if b.SelectedUnit != nil && b.SelectedUnit.Deployed {
 					b.SelectedUnit.PlaceBench(i)
 					b.SelectedUnit = nil
+					b.MazeDirty = true
 				}
 				return
 			}
@@ -265,9 +466,17 @@ func (b *BattleState) handleClick(mx, my int) {
 	// Check board clicks
 	gx, gy := b.Board.ScreenToGrid(mx, my)
 	if gx >= 0 && gx < config.BoardCols && gy >= 0 && gy < config.BoardRows {
+		// A selected consumable is thrown onto a path tile
+		if b.UseSelectedItem(gx, gy) {
+			return
+		}
+
 		// Check if clicking on a deployed unit
 		for _, u := range b.Units {
 			if u.Deployed && u.GridX == gx && u.GridY == gy {
+				if b.EquipSelectedItem(u) {
+					return
+				}
 				if b.SelectedUnit == u {
 					b.SelectedUnit = nil
 				} else {
@@ -280,17 +489,11 @@ func (b *BattleState) handleClick(mx, my int) {
 		// If we have a selected bench unit, deploy it
 		if b.SelectedUnit != nil && !b.SelectedUnit.Deployed && b.Board.CanPlace(gx, gy) {
 			if b.DeployedCount() < b.Shop.DeployCap {
-				// Check no other unit is there
-				occupied := false
-				for _, u := range b.Units {
-					if u.Deployed && u.GridX == gx && u.GridY == gy {
-						occupied = true
-						break
-					}
-				}
-				if !occupied {
+				// Check no other unit or summon is there, and the maze stays open
+				if b.canPlaceUnit(b.SelectedUnit, gx, gy) {
 					b.SelectedUnit.Place(gx, gy)
 					b.SelectedUnit = nil
+					b.MazeDirty = true
 				}
 			}
 			return
@@ -298,16 +501,10 @@ func (b *BattleState) handleClick(mx, my int) {
 
 		// If we have a selected deployed unit, move it
 		if b.SelectedUnit != nil && b.SelectedUnit.Deployed && b.Board.CanPlace(gx, gy) {
-			occupied := false
-			for _, u := range b.Units {
-				if u.Deployed && u.GridX == gx && u.GridY == gy {
-					occupied = true
-					break
-				}
-			}
-			if !occupied {
+			if b.canPlaceUnit(b.SelectedUnit, gx, gy) {
 				b.SelectedUnit.Place(gx, gy)
 				b.SelectedUnit = nil
+				b.MazeDirty = true
 			}
 			return
 		}
@@ -317,6 +514,29 @@ func (b *BattleState) handleClick(mx, my int) {
 	}
 }
 
+// togglePanel opens or closes one info panel overlay, closing the others
+func (b *BattleState) togglePanel(panel *bool) {
+	open := !*panel
+	b.ShowPool = false
+	b.ShowLedger = false
+	*panel = open
+}
+
+// shopSlotAt returns the shop slot under the cursor, or -1
+func shopSlotAt(mx, my int) int {
+	shopY := float64(config.ScreenHeight - 110)
+	if float64(my) < shopY || float64(my) >= shopY+62 {
+		return -1
+	}
+	for i := 0; i < config.ShopSlots; i++ {
+		slotX := 80 + i*150
+		if mx >= slotX && mx < slotX+140 {
+			return i
+		}
+	}
+	return -1
+}
+
 // BuyUnit purchases a unit from the shop and places it on the bench
 func (b *BattleState) BuyUnit(slot int) {
 	// Find free bench slot
@@ -335,8 +555,9 @@ func (b *BattleState) BuyUnit(slot int) {
 		}
 	}
 
-	if benchSlot == -1 {
-		return // Bench full
+	// A full bench only blocks the purchase if the new copy would not fuse away
+	if benchSlot == -1 && b.FusionPreview(b.Shop.Slots[slot]) == 0 {
+		return
 	}
 
 	unit := b.Shop.Buy(slot)
@@ -344,7 +565,9 @@ func (b *BattleState) BuyUnit(slot int) {
 		return
 	}
 
-	unit.PlaceBench(benchSlot)
+	if benchSlot >= 0 {
+		unit.PlaceBench(benchSlot)
+	}
 	b.Units = append(b.Units, unit)
 
 	// Check for TRI-FUSE (3 same units = upgrade)
@@ -359,15 +582,17 @@ func (b *BattleState) SellSelectedUnit() {
 		return
 	}
 	b.Shop.SellUnit(b.SelectedUnit)
+	b.returnItems(b.SelectedUnit)
+	b.removeUnit(b.SelectedUnit)
+}
 
-	// Remove from units list
-	for i, u := range b.Units {
-		if u == b.SelectedUnit {
-			b.Units = append(b.Units[:i], b.Units[i+1:]...)
-			break
-		}
+// TotalStats sums damage statistics over every unit fielded this battle
+func (b *BattleState) TotalStats() entity.CombatStats {
+	total := b.RetiredStats
+	for _, u := range b.Units {
+		total.Add(u.Stats)
 	}
-	b.SelectedUnit = nil
+	return total
 }
 
 // DeployedCount returns the number of deployed units
@@ -394,10 +619,16 @@ func (b *BattleState) CountSynergies() (map[config.Faction]int, map[config.UnitC
 	return factions, classes
 }
 
-// GetOccupiedNodes returns node positions that have units on them
+// GetOccupiedNodes returns active node positions that have units on them
 func (b *BattleState) GetOccupiedNodes() []config.Pos {
 	var occupied []config.Pos
-	for node := range b.Board.NodeSet {
+	for _, node := range b.Stage.Nodes {
+		if !b.Board.NodeSet[node] {
+			continue
+		}
+		if _, off := b.DisabledNodes[node]; off {
+			continue
+		}
 		for _, u := range b.Units {
 			if u.Deployed && u.GridX == node.X && u.GridY == node.Y {
 				occupied = append(occupied, node)
@@ -408,62 +639,94 @@ func (b *BattleState) GetOccupiedNodes() []config.Pos {
 	return occupied
 }
 
-// ActivateBarrier activates the barrier effect
-func (b *BattleState) ActivateBarrier() {
-	b.BarrierCooldown = 20.0 // 20 second cooldown
-	b.BarrierActive = 3.0    // 3 second duration
-
-	switch b.Stage.BarrierEffect {
-	case "BARRIER_SLOW":
-		for _, e := range b.Enemies {
-			if e.Alive && !e.Reached {
-				e.SlowTimer = 3.0
+// CheckTriFuse performs TRI-FUSE combinations for the given unit, cascading
+// up the star levels: a fusion that completes a third 2-star fuses again.
+func (b *BattleState) CheckTriFuse(unitID string) {
+	for star := 1; star < config.MaxStar; star++ {
+		var matching []*entity.Unit
+		for _, u := range b.Units {
+			if u.Def.ID == unitID && u.Star == star {
+				matching = append(matching, u)
 			}
 		}
-	case "BARRIER_MARK":
-		// Increase damage taken (simplified: reduce HP slightly)
-		for _, e := range b.Enemies {
-			if e.Alive && !e.Reached {
-				e.TakeDamage(e.MaxHP*0.05, config.DamageMagic)
-			}
+		if len(matching) < 3 {
+			continue
 		}
-	case "BARRIER_REVEAL":
-		for _, e := range b.Enemies {
-			if e.Alive && !e.Reached && e.Def.Type == config.EnemyStalker {
-				e.Visible = true
+
+		group := matching[:3]
+		keeper := fusionKeeper(group)
+		keeper.StarUp()
+		for _, rm := range group {
+			if rm == keeper {
+				continue
 			}
+			b.transferItems(rm, keeper)
+			b.removeUnit(rm)
 		}
 	}
 }
 
-// CheckTriFuse checks and performs TRI-FUSE combination
-func (b *BattleState) CheckTriFuse(unitID string) {
-	// Find all units with same ID and star level
-	for star := 1; star <= 2; star++ {
+// fusionKeeper picks the copy that survives a fusion: a deployed copy keeps
+// its board position, otherwise a benched one keeps its slot.
+func fusionKeeper(group []*entity.Unit) *entity.Unit {
+	for _, u := range group {
+		if u.Deployed {
+			return u
+		}
+	}
+	for _, u := range group {
+		if u.BenchSlot >= 0 {
+			return u
+		}
+	}
+	return group[0]
+}
+
+// removeUnit drops a unit from the battle, clearing any selection of it
+func (b *BattleState) removeUnit(rm *entity.Unit) {
+	b.RetiredStats.Add(rm.Stats)
+	b.clearSummons(rm)
+	b.clearTraps(rm)
+	for i, u := range b.Units {
+		if u == rm {
+			b.Units = append(b.Units[:i], b.Units[i+1:]...)
+			break
+		}
+	}
+	if b.SelectedUnit == rm {
+		b.SelectedUnit = nil
+	}
+	b.MazeDirty = true
+}
+
+// FusionPreview returns the star level that buying one more copy of def
+// would fuse up to, or 0 when it would not trigger a TRI-FUSE
+func (b *BattleState) FusionPreview(def *data.UnitDef) int {
+	_, star := b.fusionPlan(def)
+	return star
+}
+
+// fusionPlan works out which owned copies buying one more copy of def would
+// fuse away, cascading like CheckTriFuse, and the star level it reaches
+func (b *BattleState) fusionPlan(def *data.UnitDef) ([]*entity.Unit, int) {
+	if def == nil || !b.Stage.TriFuseEnabled {
+		return nil, 0
+	}
+	var copies []*entity.Unit
+	result := 0
+	for star := 1; star < config.MaxStar; star++ {
 		var matching []*entity.Unit
 		for _, u := range b.Units {
-			if u.Def.ID == unitID && u.Star == star {
+			if u.Def.ID == def.ID && u.Star == star {
 				matching = append(matching, u)
 			}
 		}
-
-		if len(matching) >= 3 {
-			// Fuse! Keep the first one, remove the other two
-			keeper := matching[0]
-			keeper.Star++
-			keeper.MaxHP *= 1.6
-			keeper.HP = keeper.MaxHP
-			keeper.ATK *= 1.35
-			// Remove the other 2
-			for _, rm := range matching[1:3] {
-				for i, u := range b.Units {
-					if u == rm {
-						b.Units = append(b.Units[:i], b.Units[i+1:]...)
-						break
-					}
-				}
-			}
+		// The bought copy, or the unit it fused into, joins this level
+		if len(matching) < 2 {
 			break
 		}
+		copies = append(copies, matching[:2]...)
+		result = star + 1
 	}
+	return copies, result
 }
@@ -0,0 +1,96 @@
+package battle
+
+import (
+	"neonsigil/internal/config"
+	"neonsigil/internal/data"
+	"neonsigil/internal/entity"
+)
+
+// UpdateBosses runs boss phase scripts. Each phase fires its actions once,
+// the frame the boss's HP drops to the phase threshold.
+func (b *BattleState) UpdateBosses() {
+	var summons []*entity.Enemy
+	for _, e := range b.Enemies {
+		if e.Def.Boss == nil || !e.Alive || e.Reached {
+			continue
+		}
+		for _, phase := range e.EnterPhases() {
+			for _, act := range phase.Actions {
+				summons = append(summons, b.runBossAction(e, act)...)
+			}
+		}
+	}
+	if len(summons) > 0 {
+		b.Enemies = append(b.Enemies, summons...)
+		b.WaveMgr.Track(summons...)
+	}
+
+	// Tick disabled nodes
+	for pos, t := range b.DisabledNodes {
+		t -= 1.0 / 60.0
+		if t <= 0 {
+			delete(b.DisabledNodes, pos)
+		} else {
+			b.DisabledNodes[pos] = t
+		}
+	}
+}
+
+// runBossAction applies one boss action and returns any summoned enemies
+func (b *BattleState) runBossAction(boss *entity.Enemy, act data.BossAction) []*entity.Enemy {
+	switch act.Kind {
+	case config.BossShield:
+		boss.AddShield(boss.MaxHP*act.Value, act.Duration)
+	case config.BossSpeed:
+		boss.SpeedBurst(act.Value, act.Duration)
+	case config.BossSummon:
+		def := data.EnemyDefs[act.Enemy]
+		if def == nil {
+			return nil
+		}
+		// Summons scale with the wave the boss arrived in
+		hpMul, spdMul := b.Stage.EnemyHPMul, b.Stage.EnemySpdMul
+		if w := b.WaveMgr.CurrentWave; w < len(b.Stage.Waves) {
+			hpMul, spdMul = b.WaveMgr.Multipliers(b.Stage.Waves[w])
+		}
+		summons := make([]*entity.Enemy, 0, act.Count)
+		for i := 0; i < act.Count; i++ {
+			summons = append(summons, entity.NewSummon(def, boss, hpMul, spdMul, b.Board))
+		}
+		return summons
+	case config.BossDisableNode:
+		if node, ok := b.pickNodeToDisable(); ok {
+			b.DisabledNodes[node] = act.Duration
+		}
+	}
+	return nil
+}
+
+// pickNodeToDisable prefers an occupied node, then any node not already disabled
+func (b *BattleState) pickNodeToDisable() (config.Pos, bool) {
+	occupied := make(map[config.Pos]bool)
+	for _, n := range b.GetOccupiedNodes() {
+		occupied[n] = true
+	}
+	for _, n := range b.Stage.Nodes {
+		if occupied[n] {
+			return n, true
+		}
+	}
+	for _, n := range b.Stage.Nodes {
+		if _, off := b.DisabledNodes[n]; !off {
+			return n, true
+		}
+	}
+	return config.Pos{}, false
+}
+
+// ActiveBoss returns the first live boss on the board, or nil
+func (b *BattleState) ActiveBoss() *entity.Enemy {
+	for _, e := range b.Enemies {
+		if e.Def.Boss != nil && e.Alive && !e.Reached {
+			return e
+		}
+	}
+	return nil
+}
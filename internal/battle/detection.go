@@ -0,0 +1,42 @@
+package battle
+
+import (
+	"neonsigil/internal/config"
+	"neonsigil/internal/entity"
+)
+
+// UpdateDetection recomputes stealth visibility for every live enemy.
+// An enemy is visible when it is not stealthed, is inside a detector unit's
+// radius or a revealing special tile's radius, or still has a reveal timer running.
+func (b *BattleState) UpdateDetection() {
+	for _, e := range b.Enemies {
+		if !e.Alive || e.Reached {
+			continue
+		}
+		e.UpdateVisibility(e.Def.Stealth && b.IsDetected(e))
+	}
+}
+
+// IsDetected reports whether any detection source currently covers the enemy
+func (b *BattleState) IsDetected(e *entity.Enemy) bool {
+	for _, u := range b.Units {
+		if u.Detects(e.Pos) {
+			return true
+		}
+	}
+
+	for pos := range b.Board.Specials {
+		def := b.Board.SpecialAt(pos.X, pos.Y)
+		if def == nil || def.RevealRadius <= 0 {
+			continue
+		}
+		radius := float64(def.RevealRadius)*float64(config.TileSize) + float64(config.TileSize)/2
+		ax, ay := b.Board.TileScreenPos(pos.X, pos.Y)
+		dx := e.Pos.X - (ax + float64(config.TileSize)/2)
+		dy := e.Pos.Y - (ay + float64(config.TileSize)/2)
+		if dx*dx+dy*dy <= radius*radius {
+			return true
+		}
+	}
+	return false
+}
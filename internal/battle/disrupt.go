@@ -0,0 +1,43 @@
+package battle
+
+import (
+	"math"
+
+	"neonsigil/internal/config"
+	"neonsigil/internal/data"
+	"neonsigil/internal/entity"
+)
+
+// UpdateDisruption fires disruption pulses from enemies whose timers are ready
+// and applies the effect to deployed units inside the pulse radius.
+func (b *BattleState) UpdateDisruption() {
+	for _, e := range b.Enemies {
+		if !e.UpdateDisrupt() {
+			continue
+		}
+		radius := e.Def.DisruptRadius*float64(config.TileSize) + float64(config.TileSize)/2
+		for _, u := range b.Units {
+			if !u.Deployed {
+				continue
+			}
+			ux, uy := b.Board.TileScreenPos(u.GridX, u.GridY)
+			dx := ux + float64(config.TileSize)/2 - e.Pos.X
+			dy := uy + float64(config.TileSize)/2 - e.Pos.Y
+			if math.Sqrt(dx*dx+dy*dy) > radius {
+				continue
+			}
+			if d := disruptDuration(e, u); d > 0 {
+				u.Disrupt(e.Def.Disrupt, d)
+			}
+		}
+	}
+}
+
+// disruptDuration returns the effect duration after faction, tile and item resistance
+func disruptDuration(e *entity.Enemy, u *entity.Unit) float64 {
+	d := e.Def.DisruptDuration * (1 - data.DisruptResist[u.Def.Faction])
+	if u.Tile != nil {
+		d *= 1 - u.Tile.DisruptResist
+	}
+	return d * (1 - u.DisruptResist())
+}
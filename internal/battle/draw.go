@@ -2,6 +2,7 @@ package battle
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/vector"
@@ -26,11 +27,17 @@ func (b *BattleState) Draw(screen *ebiten.Image) {
 				occupiedTiles[config.Pos{X: u.GridX, Y: u.GridY}] = true
 			}
 		}
+		for _, s := range b.Summons {
+			if s.OnTile(s.Tile.X, s.Tile.Y) {
+				occupiedTiles[s.Tile] = true
+			}
+		}
 	}
 	b.Board.DrawWithHighlight(screen, b.Tick, highlightPlaceable, occupiedTiles)
 
 	// Node connections
 	DrawNodeIndicator(screen, b, b.Tick)
+	drawDisabledNodes(screen, b, b.Tick)
 
 	// Barrier effect visual
 	if b.BarrierActive > 0 {
@@ -42,15 +49,32 @@ func (b *BattleState) Draw(screen *ebiten.Image) {
 		drawRangeIndicator(screen, b.SelectedUnit)
 	}
 
-	// Enemies
+	// Ground zones, traps and enemy auras beneath all enemies
+	drawZones(screen, b, b.Tick)
+	drawTraps(screen, b, b.Tick)
+	for _, e := range b.Enemies {
+		e.DrawAura(screen, b.Tick)
+	}
+
+	// Ground enemies
 	for _, e := range b.Enemies {
-		e.Draw(screen, b.Tick)
+		if !e.IsAir() {
+			e.Draw(screen, b.Tick)
+		}
 	}
 
 	// Units on board
 	for _, u := range b.Units {
 		u.Draw(screen, b.Tick)
 	}
+	drawSummons(screen, b, b.Tick)
+
+	// Air enemies fly above units
+	for _, e := range b.Enemies {
+		if e.IsAir() {
+			e.Draw(screen, b.Tick)
+		}
+	}
 
 	// Selected unit highlight
 	if b.SelectedUnit != nil && b.SelectedUnit.Deployed {
@@ -63,11 +87,37 @@ func (b *BattleState) Draw(screen *ebiten.Image) {
 	// Projectiles
 	entity.DrawProjectiles(screen, b.Projectiles)
 
+	// Maze route the selected unit would produce
+	drawRoutePreview(screen, b, b.Tick)
+
+	// Copies that would fuse with the hovered shop unit
+	drawFusionPreview(screen, b, b.Tick)
+
+	// Targeted barrier preview
+	if b.BarrierAiming {
+		drawBarrierAim(screen, b, b.Tick)
+	}
+
+	// Trap placement preview
+	if b.PlacingTrap != nil {
+		drawTrapAim(screen, b, b.Tick)
+	}
+
 	// UI
 	DrawHUD(screen, b, b.Tick)
 	DrawBenchUI(screen, b, b.Tick)
 	DrawShopUI(screen, b, b.Tick)
 	DrawInfoPanel(screen, b, b.Tick)
+	if b.ShowPool {
+		DrawPoolViewer(screen, b)
+	}
+	if b.ShowLedger {
+		DrawLedgerPanel(screen, b)
+	}
+	DrawTileTooltip(screen, b)
+	if b.BtnStartWave.Hovered && !b.WaveMgr.WaveActive {
+		DrawIncomeTooltip(screen, b)
+	}
 
 	// Game over overlay
 	if b.GameOver {
@@ -75,16 +125,93 @@ func (b *BattleState) Draw(screen *ebiten.Image) {
 	}
 }
 
+// drawFusionPreview rings the units a hovered shop purchase would fuse with
+func drawFusionPreview(screen *ebiten.Image, battle *BattleState, tick int) {
+	slot := shopSlotAt(battle.CursorX, battle.CursorY)
+	if slot < 0 {
+		return
+	}
+	copies, _ := battle.fusionPlan(battle.Shop.Slots[slot])
+	if len(copies) == 0 {
+		return
+	}
+	pulse := math.Sin(float64(tick%30)/30.0*math.Pi*2)*0.3 + 0.7
+	clr := config.WithAlpha(config.ColorNeonYellow, uint8(220*pulse))
+	for _, u := range copies {
+		var cx, cy float32
+		switch {
+		case u.Deployed:
+			cx = float32(config.BoardOffsetX+u.GridX*config.TileSize) + float32(config.TileSize)/2
+			cy = float32(config.BoardOffsetY+u.GridY*config.TileSize) + float32(config.TileSize)/2
+		case u.BenchSlot >= 0:
+			cx = float32(config.BenchSlotX(u.BenchSlot)) + 25
+			cy = float32(config.BenchSlotY()) + 25
+		default:
+			continue
+		}
+		vector.StrokeCircle(screen, cx, cy, 30, 2, clr, false)
+	}
+}
+
+func drawBarrierAim(screen *ebiten.Image, battle *BattleState, tick int) {
+	def := battle.Barrier()
+	if def == nil {
+		return
+	}
+	pulse := math.Sin(float64(tick%30)/30.0*math.Pi*2)*0.3 + 0.7
+	r := float32(def.Radius * float64(config.TileSize))
+	cx := float32(battle.CursorX)
+	cy := float32(battle.CursorY)
+	vector.DrawFilledCircle(screen, cx, cy, r, config.WithAlpha(config.ColorNeonBlue, uint8(40*pulse)), false)
+	vector.StrokeCircle(screen, cx, cy, r, 2, config.WithAlpha(config.ColorNeonCyan, uint8(200*pulse)), false)
+}
+
 func drawRangeIndicator(screen *ebiten.Image, u *entity.Unit) {
 	cx := float32(config.BoardOffsetX+u.GridX*config.TileSize) + float32(config.TileSize)/2
 	cy := float32(config.BoardOffsetY+u.GridY*config.TileSize) + float32(config.TileSize)/2
-	r := float32(u.Range*config.TileSize) + float32(config.TileSize)/2
+	r := float32(u.EffectiveRange()*config.TileSize) + float32(config.TileSize)/2
 	vector.StrokeCircle(screen, cx, cy, r, 1, config.WithAlpha(config.ColorNeonCyan, 60), false)
+
+	// Stealth detection radius
+	if u.Def.DetectRange > 0 {
+		dr := float32(u.Def.DetectRange*config.TileSize) + float32(config.TileSize)/2
+		vector.StrokeCircle(screen, cx, cy, dr, 1, config.WithAlpha(config.ColorNeonGreen, 60), false)
+	}
+}
+
+func drawDisabledNodes(screen *ebiten.Image, battle *BattleState, tick int) {
+	for pos, t := range battle.DisabledNodes {
+		cx := float32(config.BoardOffsetX+pos.X*config.TileSize) + float32(config.TileSize)/2
+		cy := float32(config.BoardOffsetY+pos.Y*config.TileSize) + float32(config.TileSize)/2
+		s := float32(config.TileSize/2 - 8)
+		c := config.WithAlpha(config.ColorNeonRed, 200)
+		if tick%20 < 10 {
+			c = config.WithAlpha(config.ColorNeonRed, 120)
+		}
+		vector.StrokeLine(screen, cx-s, cy-s, cx+s, cy+s, 3, c, false)
+		vector.StrokeLine(screen, cx-s, cy+s, cx+s, cy-s, 3, c, false)
+		ui.DrawTextCentered(screen, fmt.Sprintf("%.0f", t), ui.FontBold(9), float64(cx), float64(cy+s+4), config.ColorNeonRed)
+	}
 }
 
 func drawBarrierEffect(screen *ebiten.Image, battle *BattleState, tick int) {
-	// Full screen overlay flash
-	alpha := uint8(battle.BarrierActive / 3.0 * 30)
+	alpha := uint8(30)
+	def := battle.Barrier()
+	if def != nil && def.Duration > 0 {
+		alpha = uint8(battle.BarrierActive / def.Duration * 30)
+	}
+
+	// Targeted barriers flash only their area
+	if def != nil && def.Radius > 0 {
+		r := float32(def.Radius * float64(config.TileSize))
+		cx := float32(battle.BarrierTarget.X)
+		cy := float32(battle.BarrierTarget.Y)
+		vector.DrawFilledCircle(screen, cx, cy, r, config.WithAlpha(config.ColorNeonBlue, alpha*2), false)
+		vector.StrokeCircle(screen, cx, cy, r, 2, config.WithAlpha(config.ColorNeonCyan, alpha*5), false)
+		return
+	}
+
+	// Full board overlay flash
 	vector.DrawFilledRect(screen, float32(config.BoardOffsetX), float32(config.BoardOffsetY),
 		float32(config.BoardCols*config.TileSize), float32(config.BoardRows*config.TileSize),
 		config.WithAlpha(config.ColorNeonBlue, alpha), false)
@@ -107,4 +234,9 @@ func drawGameOverlay(screen *ebiten.Image, battle *BattleState, tick int) {
 	ui.DrawTextCentered(screen, fmt.Sprintf("Kills: %d   Waves: %d/%d",
 		battle.KillCount, battle.WaveMgr.CurrentWave, battle.WaveMgr.TotalWaves()),
 		ui.FontRegular(11), config.ScreenWidth/2, statsY, config.ColorWhiteDim)
+
+	st := battle.TotalStats()
+	ui.DrawTextCentered(screen, fmt.Sprintf("Damage: %d   Overkill: %d   Wasted: %d (%d fizzled)",
+		int(st.Dealt), int(st.Overkill), int(st.Wasted), st.Fizzled),
+		ui.FontRegular(11), config.ScreenWidth/2, statsY+20, config.ColorWhiteDim)
 }
@@ -0,0 +1,111 @@
+package battle
+
+import (
+	"neonsigil/internal/config"
+	"neonsigil/internal/entity"
+)
+
+// FormationSlot records one deployed unit of a saved formation
+type FormationSlot struct {
+	UnitID       string
+	Star         int
+	GridX, GridY int
+	Targeting    config.TargetMode
+}
+
+// Formation is a saved board layout
+type Formation struct {
+	Slots []FormationSlot
+}
+
+// HasFormation reports whether a formation has been saved this battle
+func (b *BattleState) HasFormation() bool {
+	return b.Formation != nil
+}
+
+// SaveFormation stores the positions and targeting of all deployed units
+func (b *BattleState) SaveFormation() {
+	var f Formation
+	for _, u := range b.Units {
+		if u.Deployed {
+			f.Slots = append(f.Slots, FormationSlot{
+				UnitID: u.Def.ID, Star: u.Star,
+				GridX: u.GridX, GridY: u.GridY,
+				Targeting: u.Targeting,
+			})
+		}
+	}
+	b.Formation = &f
+}
+
+// LoadFormation moves owned units into the saved formation and restores
+// their targeting. Each slot takes a matching unit (same ID and star),
+// preferring one already deployed; a unit in the way swaps places.
+func (b *BattleState) LoadFormation() {
+	if b.Formation == nil {
+		return
+	}
+	used := make(map[*entity.Unit]bool)
+	for _, slot := range b.Formation.Slots {
+		u := b.formationCandidate(slot, used)
+		if u == nil {
+			continue
+		}
+		used[u] = true
+		u.Targeting = slot.Targeting
+		b.moveUnitTo(u, slot.GridX, slot.GridY)
+	}
+	b.SelectedUnit = nil
+}
+
+// formationCandidate picks an unused unit for a formation slot
+func (b *BattleState) formationCandidate(slot FormationSlot, used map[*entity.Unit]bool) *entity.Unit {
+	var fallback *entity.Unit
+	for _, u := range b.Units {
+		if used[u] || u.Def.ID != slot.UnitID || u.Star != slot.Star {
+			continue
+		}
+		if u.Deployed {
+			if u.GridX == slot.GridX && u.GridY == slot.GridY {
+				return u
+			}
+			if fallback == nil || !fallback.Deployed {
+				fallback = u
+			}
+		} else if fallback == nil && u.BenchSlot >= 0 {
+			fallback = u
+		}
+	}
+	return fallback
+}
+
+// unitAt returns the deployed unit on the given tile, or nil
+func (b *BattleState) unitAt(gx, gy int) *entity.Unit {
+	for _, u := range b.Units {
+		if u.Deployed && u.GridX == gx && u.GridY == gy {
+			return u
+		}
+	}
+	return nil
+}
+
+// moveUnitTo places a unit on a tile, swapping with any unit already there
+func (b *BattleState) moveUnitTo(u *entity.Unit, gx, gy int) {
+	occupant := b.unitAt(gx, gy)
+	switch {
+	case occupant == u:
+		return
+	case occupant != nil:
+		if u.Deployed {
+			occupant.Place(u.GridX, u.GridY)
+		} else {
+			occupant.PlaceBench(u.BenchSlot)
+		}
+	case !u.Deployed && b.DeployedCount() >= b.Shop.DeployCap:
+		return
+	case !b.canPlaceUnit(u, gx, gy):
+		return
+	}
+	u.Place(gx, gy)
+	b.MazeDirty = true
+}
@@ -0,0 +1,184 @@
+package battle
+
+import (
+	"fmt"
+	"strings"
+
+	"neonsigil/internal/config"
+	"neonsigil/internal/data"
+	"neonsigil/internal/entity"
+)
+
+// Inventory layout, to the right of the bench
+const (
+	inventorySlotSize = 40
+	inventoryX        = 620
+)
+
+// inventorySlotX returns the screen X coordinate for an inventory slot
+func inventorySlotX(slot int) int {
+	return inventoryX + slot*(inventorySlotSize+6)
+}
+
+// ItemsEnabled reports whether the stage has a WORKBENCH, which unlocks
+// component drops, crafting and equipment.
+func (b *BattleState) ItemsEnabled() bool {
+	for _, sp := range b.Board.Specials {
+		if sp == config.SpecialWorkbench {
+			return true
+		}
+	}
+	return false
+}
+
+// CanCraft reports whether a deployed unit is manning a WORKBENCH tile
+func (b *BattleState) CanCraft() bool {
+	for _, u := range b.Units {
+		if u.Deployed && b.Board.Specials[config.Pos{X: u.GridX, Y: u.GridY}] == config.SpecialWorkbench {
+			return true
+		}
+	}
+	return false
+}
+
+// AddItem puts an item into the inventory; returns false when it is full
+func (b *BattleState) AddItem(it *data.ItemDef) bool {
+	if it == nil || len(b.Inventory) >= config.InventorySlots {
+		return false
+	}
+	b.Inventory = append(b.Inventory, it)
+	return true
+}
+
+// removeItem takes the item at the given inventory index out
+func (b *BattleState) removeItem(idx int) *data.ItemDef {
+	it := b.Inventory[idx]
+	b.Inventory = append(b.Inventory[:idx], b.Inventory[idx+1:]...)
+	b.SelectedItem = -1
+	return it
+}
+
+// BuyComponent buys a random component into the inventory
+func (b *BattleState) BuyComponent() {
+	if !b.ItemsEnabled() || len(b.Inventory) >= config.InventorySlots {
+		return
+	}
+	b.AddItem(b.Shop.BuyComponent())
+}
+
+// dropComponent awards a random component or consumable at the end of a wave
+func (b *BattleState) dropComponent() {
+	if !b.ItemsEnabled() {
+		return
+	}
+	comps := data.GetDrops()
+	if len(comps) == 0 {
+		return
+	}
+	b.AddItem(comps[b.Rng.Intn(len(comps))])
+}
+
+// ClickItem handles a click on an inventory slot: select, craft with the
+// already-selected component, or deselect.
+func (b *BattleState) ClickItem(idx int) {
+	if idx < 0 || idx >= len(b.Inventory) {
+		b.SelectedItem = -1
+		return
+	}
+	sel := b.SelectedItem
+	if sel < 0 || sel == idx {
+		if sel == idx {
+			b.SelectedItem = -1
+		} else {
+			b.SelectedItem = idx
+			b.SelectedUnit = nil
+		}
+		return
+	}
+
+	recipe := data.FindRecipe(b.Inventory[sel].ID, b.Inventory[idx].ID)
+	if recipe == nil || !b.CanCraft() {
+		b.SelectedItem = idx
+		return
+	}
+	// Remove the higher index first so the lower one stays valid
+	hi, lo := max(sel, idx), min(sel, idx)
+	b.removeItem(hi)
+	b.removeItem(lo)
+	b.stashItem(recipe)
+}
+
+// EquipSelectedItem moves the selected inventory item onto a unit
+func (b *BattleState) EquipSelectedItem(u *entity.Unit) bool {
+	if b.SelectedItem < 0 || b.SelectedItem >= len(b.Inventory) || !u.CanEquip() {
+		return false
+	}
+	if b.Inventory[b.SelectedItem].Consumable {
+		return false
+	}
+	u.Equip(b.removeItem(b.SelectedItem))
+	return true
+}
+
+// stashItem puts an equipped or crafted item into the inventory. Unlike
+// AddItem it may overflow past config.InventorySlots so equipment is never
+// destroyed; buying and drops stay blocked until the overflow is used up.
+func (b *BattleState) stashItem(it *data.ItemDef) {
+	b.Inventory = append(b.Inventory, it)
+}
+
+// returnItems moves a unit's items back into the inventory
+func (b *BattleState) returnItems(u *entity.Unit) {
+	for _, it := range u.Items {
+		b.stashItem(it)
+	}
+	u.Items = nil
+}
+
+// transferItems moves items from a fused-away unit onto the keeper,
+// spilling anything that does not fit into the inventory
+func (b *BattleState) transferItems(from, to *entity.Unit) {
+	for _, it := range from.Items {
+		if !to.Equip(it) {
+			b.stashItem(it)
+		}
+	}
+	from.Items = nil
+}
+
+// inventoryLen returns the number of inventory slots to show, including
+// any overflow stashed from sold or fused units
+func (b *BattleState) inventoryLen() int {
+	return max(config.InventorySlots, len(b.Inventory))
+}
+
+// itemSummary describes an item's bonuses in one short line
+func itemSummary(it *data.ItemDef) string {
+	var parts []string
+	if it.ATKPct > 0 {
+		parts = append(parts, fmt.Sprintf("ATK+%d%%", int(it.ATKPct*100)))
+	}
+	if it.AtkSpeedPct > 0 {
+		parts = append(parts, fmt.Sprintf("SPD+%d%%", int(it.AtkSpeedPct*100)))
+	}
+	if it.RangeBonus > 0 {
+		parts = append(parts, fmt.Sprintf("RNG+%d", it.RangeBonus))
+	}
+	if it.AirMulBonus > 0 {
+		parts = append(parts, fmt.Sprintf("AIR+%.2f", it.AirMulBonus))
+	}
+	if it.DisruptRes > 0 {
+		parts = append(parts, fmt.Sprintf("HACK-RES %d%%", int(it.DisruptRes*100)))
+	}
+	if oh := it.OnHit; oh != nil {
+		s := fmt.Sprintf("ON-HIT %s %.1fs", oh.Kind, oh.Duration)
+		if oh.Every > 1 {
+			s += fmt.Sprintf(" /%d", oh.Every)
+		}
+		parts = append(parts, s)
+	}
+	if zd := data.ZoneDefs[it.Zone]; zd != nil {
+		parts = append(parts, fmt.Sprintf("PATH %s %.0fs", zd.Name, zd.Duration))
+	}
+	return strings.Join(parts, " ")
+}
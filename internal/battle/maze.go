@@ -0,0 +1,102 @@
+package battle
+
+import (
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"neonsigil/internal/config"
+	"neonsigil/internal/entity"
+	"neonsigil/internal/ui"
+)
+
+// unitTiles returns the tiles held by deployed units, leaving out except
+// (the unit being moved)
+func (b *BattleState) unitTiles(except *entity.Unit) map[config.Pos]bool {
+	tiles := make(map[config.Pos]bool)
+	for _, u := range b.Units {
+		if u.Deployed && u != except {
+			tiles[config.Pos{X: u.GridX, Y: u.GridY}] = true
+		}
+	}
+	return tiles
+}
+
+// mazeAllows reports whether putting u on the given tile keeps every maze
+// route open. During a wave routes are frozen, so route tiles are off-limits.
+func (b *BattleState) mazeAllows(u *entity.Unit, gx, gy int) bool {
+	if !b.Board.Maze {
+		return true
+	}
+	if b.WaveMgr.WaveActive {
+		return !b.Board.IsPath(gx, gy)
+	}
+	blocked := b.unitTiles(u)
+	blocked[config.Pos{X: gx, Y: gy}] = true
+	return b.Board.Routable(blocked)
+}
+
+// canPlaceUnit reports whether u can be deployed or moved onto a tile.
+// Maze route tiles are buildable, so live traps have to be checked too.
+func (b *BattleState) canPlaceUnit(u *entity.Unit, gx, gy int) bool {
+	return b.Board.CanPlace(gx, gy) && !b.tileOccupied(gx, gy) && b.trapAt(gx, gy) == nil &&
+		b.mazeAllows(u, gx, gy)
+}
+
+// UpdateMaze reroutes the maze around the deployed units once units have
+// moved, waiting for the wave to end, and lifts any trap the new routes
+// no longer cross
+func (b *BattleState) UpdateMaze() {
+	if !b.Board.Maze || !b.MazeDirty || b.WaveMgr.WaveActive {
+		return
+	}
+	b.Board.Reroute(b.unitTiles(nil))
+	b.liftStrandedTraps()
+	b.MazeDirty = false
+}
+
+// drawRoutePreview shows the routes enemies would take if the selected unit
+// were put on the hovered tile, or marks the tile when it would seal the maze
+func drawRoutePreview(screen *ebiten.Image, battle *BattleState, tick int) {
+	u := battle.SelectedUnit
+	if !battle.Board.Maze || battle.WaveMgr.WaveActive || u == nil {
+		return
+	}
+	mx, my := battle.CursorX, battle.CursorY
+	gx, gy := battle.Board.ScreenToGrid(mx, my)
+	if mx < config.BoardOffsetX || my < config.BoardOffsetY || !battle.Board.CanPlace(gx, gy) || battle.tileOccupied(gx, gy) ||
+		battle.trapAt(gx, gy) != nil {
+		return
+	}
+	if u.Deployed && u.GridX == gx && u.GridY == gy {
+		return
+	}
+
+	blocked := battle.unitTiles(u)
+	blocked[config.Pos{X: gx, Y: gy}] = true
+	routes := battle.Board.PreviewRoutes(blocked)
+
+	x := float32(config.BoardOffsetX + gx*config.TileSize)
+	y := float32(config.BoardOffsetY + gy*config.TileSize)
+	ts := float32(config.TileSize)
+	if routes == nil {
+		vector.StrokeLine(screen, x+10, y+10, x+ts-10, y+ts-10, 3, config.ColorNeonRed, false)
+		vector.StrokeLine(screen, x+ts-10, y+10, x+10, y+ts-10, 3, config.ColorNeonRed, false)
+		ui.DrawTextCentered(screen, "BLOCKS PATH", ui.FontBold(8), float64(x+ts/2), float64(y)-10, config.ColorNeonRed)
+		return
+	}
+
+	pulse := uint8(140 + 80*math.Sin(float64(tick)*0.12))
+	clr := config.WithAlpha(config.ColorNeonYellow, pulse)
+	for _, route := range routes {
+		for i := 0; i+1 < len(route); i++ {
+			x1 := float32(config.BoardOffsetX+route[i].X*config.TileSize) + ts/2
+			y1 := float32(config.BoardOffsetY+route[i].Y*config.TileSize) + ts/2
+			x2 := float32(config.BoardOffsetX+route[i+1].X*config.TileSize) + ts/2
+			y2 := float32(config.BoardOffsetY+route[i+1].Y*config.TileSize) + ts/2
+			vector.StrokeLine(screen, x1, y1, x2, y2, 2, clr, false)
+		}
+	}
+	ui.DrawTextCentered(screen, "NEW ROUTE", ui.FontRegular(8), float64(x+ts/2), float64(y)-10, config.ColorNeonYellow)
+}
@@ -0,0 +1,96 @@
+package battle
+
+import (
+	"math"
+
+	"neonsigil/internal/config"
+	"neonsigil/internal/data"
+)
+
+// RouteChoice records which branch an enemy took at a fork
+type RouteChoice struct {
+	Wave   int    // wave index
+	Serial int    // enemy spawn order within the wave
+	Fork   string // path that forked
+	Branch string // path taken
+}
+
+// resolveForks sends every enemy waiting at a fork down a branch
+func (b *BattleState) resolveForks() {
+	for _, e := range b.Enemies {
+		if !e.AtFork || !e.Alive || e.Reached {
+			continue
+		}
+		fork := b.Board.PathByID(e.PathID)
+		if fork == nil || len(fork.Branches) == 0 {
+			e.AtFork = false
+			continue
+		}
+		branch := b.chooseBranch(fork)
+		b.RouteLog = append(b.RouteLog, RouteChoice{
+			Wave: b.WaveMgr.CurrentWave, Serial: e.Serial, Fork: fork.ID, Branch: branch,
+		})
+		e.TakeBranch(branch)
+	}
+}
+
+// chooseBranch picks the branch an enemy takes at a fork by the fork's rule
+func (b *BattleState) chooseBranch(fork *data.PathDef) string {
+	branches := fork.Branches
+	if len(branches) == 1 {
+		return branches[0].PathID
+	}
+	switch fork.BranchRule {
+	case config.BranchRandom:
+		return branches[b.RouteRng.Intn(len(branches))].PathID
+	case config.BranchAlternate:
+		turn := b.forkTurns[fork.ID]
+		b.forkTurns[fork.ID] = turn + 1
+		return branches[turn%len(branches)].PathID
+	case config.BranchLeastDefended:
+		best, bestCover := branches[0].PathID, math.MaxInt
+		for _, br := range branches {
+			if cover := b.branchCoverage(br.PathID); cover < bestCover {
+				best, bestCover = br.PathID, cover
+			}
+		}
+		return best
+	}
+
+	total := 0.0
+	for _, br := range branches {
+		total += data.BranchWeight(br)
+	}
+	roll := b.RouteRng.Float64() * total
+	for _, br := range branches {
+		roll -= data.BranchWeight(br)
+		if roll < 0 {
+			return br.PathID
+		}
+	}
+	return branches[len(branches)-1].PathID
+}
+
+// branchCoverage counts unit/tile pairs where a deployed unit can reach a
+// tile of the branch path
+func (b *BattleState) branchCoverage(pathID string) int {
+	pd := b.Board.PathByID(pathID)
+	if pd == nil {
+		return 0
+	}
+	cover := 0
+	for _, u := range b.Units {
+		if !u.Deployed {
+			continue
+		}
+		rng := float64(u.EffectiveRange()) + 0.5
+		for _, wp := range pd.Waypoints {
+			dx := float64(wp.X - u.GridX)
+			dy := float64(wp.Y - u.GridY)
+			if math.Sqrt(dx*dx+dy*dy) <= rng {
+				cover++
+			}
+		}
+	}
+	return cover
+}
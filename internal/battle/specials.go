@@ -0,0 +1,57 @@
+package battle
+
+import (
+	"neonsigil/internal/config"
+	"neonsigil/internal/entity"
+)
+
+// sealSlowRefresh keeps enemies slowed while they stay inside a SEAL area
+const sealSlowRefresh = 0.2
+
+// UpdateSpecials refreshes the special tile under every unit and applies
+// special tile area effects to enemies.
+func (b *BattleState) UpdateSpecials() {
+	occupied := make(map[config.Pos]bool)
+	for _, u := range b.Units {
+		u.Tile = nil
+		if u.Deployed {
+			u.Tile = b.Board.SpecialAt(u.GridX, u.GridY)
+			occupied[config.Pos{X: u.GridX, Y: u.GridY}] = true
+		}
+	}
+
+	for pos := range b.Board.Specials {
+		def := b.Board.SpecialAt(pos.X, pos.Y)
+		if def == nil || def.Radius <= 0 {
+			continue
+		}
+		if def.NeedsUnit && !occupied[pos] {
+			continue
+		}
+		tx, ty := b.Board.TileScreenPos(pos.X, pos.Y)
+		cx := tx + float64(config.TileSize)/2
+		cy := ty + float64(config.TileSize)/2
+		radius := def.Radius*float64(config.TileSize) + float64(config.TileSize)/2
+		for _, e := range b.Enemies {
+			if !e.Alive || e.Reached || e.IsAir() {
+				continue
+			}
+			dx := e.Pos.X - cx
+			dy := e.Pos.Y - cy
+			if dx*dx+dy*dy > radius*radius {
+				continue
+			}
+			applySpecialArea(e, def.HaltTime, def.Slows)
+		}
+	}
+}
+
+func applySpecialArea(e *entity.Enemy, halt float64, slows bool) {
+	if halt > 0 && !e.SealHalted {
+		e.SealHalted = true
+		e.Stun(halt)
+	}
+	if slows && e.SlowTimer < sealSlowRefresh {
+		e.SlowTimer = sealSlowRefresh
+	}
+}
@@ -0,0 +1,155 @@
+package battle
+
+import (
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"neonsigil/internal/config"
+	"neonsigil/internal/entity"
+)
+
+// UpdateSummons recharges the summon skills of deployed units, creates new
+// summons while a wave is running, then runs and culls the live ones.
+// Blocks recompute which enemies they hold every tick.
+func (b *BattleState) UpdateSummons() {
+	for _, e := range b.Enemies {
+		e.Blocked = false
+	}
+
+	if b.WaveMgr.WaveActive {
+		for _, u := range b.Units {
+			sd := u.Def.Summon
+			if sd == nil || !u.Deployed {
+				continue
+			}
+			u.SummonTimer -= (1 + b.summonCDR(u)) / 60.0
+			if u.SummonTimer > 0 || b.summonCount(u) >= max(sd.Max, 1) {
+				continue
+			}
+			if b.spawnSummon(u) {
+				u.SummonTimer = sd.Cooldown
+			}
+		}
+	}
+
+	alive := b.Summons[:0]
+	for _, s := range b.Summons {
+		s.Update(b.Enemies, &b.Projectiles)
+		if s.Alive {
+			alive = append(alive, s)
+		}
+	}
+	b.Summons = alive
+}
+
+// summonCDR returns the cooldown reduction turrets grant to u (strongest wins)
+func (b *BattleState) summonCDR(u *entity.Unit) float64 {
+	cdr := 0.0
+	for _, s := range b.Summons {
+		if s.Owner != u && s.Covers(u) {
+			cdr = math.Max(cdr, s.Def.CDR)
+		}
+	}
+	return cdr
+}
+
+// summonCount returns the number of live summons owned by u
+func (b *BattleState) summonCount(u *entity.Unit) int {
+	n := 0
+	for _, s := range b.Summons {
+		if s.Alive && s.Owner == u {
+			n++
+		}
+	}
+	return n
+}
+
+// spawnSummon creates u's summon: drones on its orbit, blocks on the closest
+// free path tile in range, turrets on a free tile next to it. Returns false
+// when there is nowhere to put it.
+func (b *BattleState) spawnSummon(u *entity.Unit) bool {
+	origin := config.Pos{X: u.GridX, Y: u.GridY}
+	var s *entity.Summon
+	switch u.Def.Summon.Kind {
+	case config.SummonDrone:
+		s = entity.NewUnitSummon(u, origin)
+		s.Angle = float64(b.summonCount(u)) * math.Pi
+	case config.SummonBlock:
+		tile, ok := b.freeSummonTile(origin, u.EffectiveRange(), b.Board.IsPath)
+		if !ok {
+			return false
+		}
+		s = entity.NewUnitSummon(u, tile)
+	case config.SummonTurret:
+		// Turrets never stand on a maze route
+		tile, ok := b.freeSummonTile(origin, 1, func(x, y int) bool {
+			return b.Board.CanPlace(x, y) && !b.Board.IsPath(x, y)
+		})
+		if !ok {
+			return false
+		}
+		s = entity.NewUnitSummon(u, tile)
+	default:
+		return false
+	}
+	b.Summons = append(b.Summons, s)
+	return true
+}
+
+// freeSummonTile finds the closest tile within rng of origin that passes
+// allowed and holds no unit or summon
+func (b *BattleState) freeSummonTile(origin config.Pos, rng int, allowed func(x, y int) bool) (config.Pos, bool) {
+	best := config.Pos{}
+	bestDist := math.MaxFloat64
+	for dx := -rng; dx <= rng; dx++ {
+		for dy := -rng; dy <= rng; dy++ {
+			x, y := origin.X+dx, origin.Y+dy
+			if (dx == 0 && dy == 0) || !allowed(x, y) || b.tileOccupied(x, y) {
+				continue
+			}
+			d := math.Sqrt(float64(dx*dx + dy*dy))
+			if d > float64(rng)+0.5 || d >= bestDist {
+				continue
+			}
+			best, bestDist = config.Pos{X: x, Y: y}, d
+		}
+	}
+	return best, bestDist < math.MaxFloat64
+}
+
+// tileOccupied reports whether a deployed unit or a summon stands on a tile
+func (b *BattleState) tileOccupied(gx, gy int) bool {
+	if b.unitAt(gx, gy) != nil {
+		return true
+	}
+	for _, s := range b.Summons {
+		if s.OnTile(gx, gy) {
+			return true
+		}
+	}
+	return false
+}
+
+// clearSummons removes summons owned by u, or every summon when u is nil
+func (b *BattleState) clearSummons(u *entity.Unit) {
+	alive := b.Summons[:0]
+	for _, s := range b.Summons {
+		if u != nil && s.Owner != u {
+			alive = append(alive, s)
+		}
+	}
+	b.Summons = alive
+	for _, o := range b.Units {
+		if u == nil || o == u {
+			o.SummonTimer = 0
+		}
+	}
+}
+
+// drawSummons renders every live summon
+func drawSummons(screen *ebiten.Image, battle *BattleState, tick int) {
+	for _, s := range battle.Summons {
+		s.Draw(screen, tick)
+	}
+}
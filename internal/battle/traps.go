@@ -0,0 +1,207 @@
+package battle
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"neonsigil/internal/config"
+	"neonsigil/internal/data"
+	"neonsigil/internal/entity"
+	"neonsigil/internal/ui"
+)
+
+// Trap shop layout, right of the shop lock button
+const (
+	trapBtnX = 980
+	trapBtnW = 90
+)
+
+// UpdateTraps lets trap-laying units drop their skill traps while a wave is
+// running, then triggers, rearms and culls every trap on the path
+func (b *BattleState) UpdateTraps() {
+	if b.WaveMgr.WaveActive {
+		for _, u := range b.Units {
+			ts := u.Def.TrapSkill
+			if ts == nil || !u.Deployed || b.skillTrap(u) != nil {
+				continue
+			}
+			u.TrapTimer -= 1.0 / 60.0
+			if u.TrapTimer > 0 {
+				continue
+			}
+			tile, ok := b.freeTrapTile(u)
+			if ok && b.addTrap(data.TrapDefByID[ts.Trap], tile, u) {
+				u.TrapTimer = ts.Cooldown
+			}
+		}
+	}
+
+	alive := b.Traps[:0]
+	for _, t := range b.Traps {
+		t.Update(b.Enemies)
+		if t.Alive || t.FlashTimer > 0 {
+			alive = append(alive, t)
+		}
+	}
+	b.Traps = alive
+}
+
+// BoughtTraps returns the number of live traps bought from the shop
+func (b *BattleState) BoughtTraps() int {
+	n := 0
+	for _, t := range b.Traps {
+		if t.Alive && t.Owner == nil {
+			n++
+		}
+	}
+	return n
+}
+
+// skillTrap returns the live trap laid by u, or nil
+func (b *BattleState) skillTrap(u *entity.Unit) *entity.Trap {
+	for _, t := range b.Traps {
+		if t.Alive && t.Owner == u {
+			return t
+		}
+	}
+	return nil
+}
+
+// trapAt returns the live trap on the given tile, or nil
+func (b *BattleState) trapAt(gx, gy int) *entity.Trap {
+	for _, t := range b.Traps {
+		if t.Alive && t.Tile.X == gx && t.Tile.Y == gy {
+			return t
+		}
+	}
+	return nil
+}
+
+// CanPlaceTrap reports whether a trap can go on the given tile
+func (b *BattleState) CanPlaceTrap(gx, gy int) bool {
+	return b.Board.IsPath(gx, gy) && b.trapAt(gx, gy) == nil
+}
+
+// liftStrandedTraps removes traps a maze reroute left off every route.
+// Bought traps are refunded for their remaining charges.
+func (b *BattleState) liftStrandedTraps() {
+	alive := b.Traps[:0]
+	for _, t := range b.Traps {
+		if t.Alive && !b.Board.IsPath(t.Tile.X, t.Tile.Y) {
+			if t.Owner == nil {
+				b.Shop.AddGold(t.Def.Cost*t.Charges/max(t.Def.Charges, 1), config.GoldRefund)
+			}
+			continue
+		}
+		alive = append(alive, t)
+	}
+	b.Traps = alive
+}
+
+// addTrap puts a trap on a free path tile
+func (b *BattleState) addTrap(def *data.TrapDef, tile config.Pos, owner *entity.Unit) bool {
+	if def == nil || !b.CanPlaceTrap(tile.X, tile.Y) {
+		return false
+	}
+	b.Traps = append(b.Traps, entity.NewTrap(def, tile, owner))
+	return true
+}
+
+// freeTrapTile finds the free path tile in u's range that enemies reach
+// first, so skill traps go out ahead of the wave
+func (b *BattleState) freeTrapTile(u *entity.Unit) (config.Pos, bool) {
+	rng := float64(u.EffectiveRange()) + 0.5
+	for _, pd := range b.Board.PathDefs {
+		for _, wp := range pd.Waypoints {
+			dx := float64(wp.X - u.GridX)
+			dy := float64(wp.Y - u.GridY)
+			if math.Sqrt(dx*dx+dy*dy) <= rng && b.CanPlaceTrap(wp.X, wp.Y) {
+				return wp, true
+			}
+		}
+	}
+	return config.Pos{}, false
+}
+
+// SelectTrap arms or cancels trap placement for the given shop trap
+func (b *BattleState) SelectTrap(def *data.TrapDef) {
+	if b.PlacingTrap == def {
+		b.PlacingTrap = nil
+		return
+	}
+	b.PlacingTrap = def
+	b.SelectedUnit = nil
+	b.SelectedItem = -1
+}
+
+// PlaceTrap buys the trap being placed and puts it on the given tile
+func (b *BattleState) PlaceTrap(gx, gy int) {
+	def := b.PlacingTrap
+	b.PlacingTrap = nil
+	if b.BoughtTraps() >= config.MaxTraps || !b.CanPlaceTrap(gx, gy) || !b.Shop.CanBuyTrap(def) {
+		return
+	}
+	b.Shop.BuyTrap(def)
+	b.addTrap(def, config.Pos{X: gx, Y: gy}, nil)
+}
+
+// clearTraps removes the skill traps laid by u
+func (b *BattleState) clearTraps(u *entity.Unit) {
+	alive := b.Traps[:0]
+	for _, t := range b.Traps {
+		if t.Owner != u {
+			alive = append(alive, t)
+		}
+	}
+	b.Traps = alive
+}
+
+// drawTraps renders every trap; drawn beneath all enemies
+func drawTraps(screen *ebiten.Image, battle *BattleState, tick int) {
+	for _, t := range battle.Traps {
+		t.Draw(screen, tick)
+	}
+}
+
+// drawTrapAim outlines the hovered path tile while placing a trap
+func drawTrapAim(screen *ebiten.Image, battle *BattleState, tick int) {
+	gx, gy := battle.Board.ScreenToGrid(battle.CursorX, battle.CursorY)
+	if battle.CursorX < config.BoardOffsetX || battle.CursorY < config.BoardOffsetY || !battle.Board.IsPath(gx, gy) {
+		return
+	}
+	clr := battle.PlacingTrap.Color
+	if !battle.CanPlaceTrap(gx, gy) {
+		clr = config.ColorNeonRed
+	}
+	pulse := uint8(150 + 100*math.Sin(float64(tick)*0.15))
+	x := float32(config.BoardOffsetX + gx*config.TileSize)
+	y := float32(config.BoardOffsetY + gy*config.TileSize)
+	vector.StrokeRect(screen, x+2, y+2, config.TileSize-4, config.TileSize-4, 2, config.WithAlpha(clr, pulse), false)
+}
+
+// drawTrapShop draws the trap buttons in the shop bar
+func drawTrapShop(screen *ebiten.Image, battle *BattleState, tick int, shopY float64) {
+	full := battle.BoughtTraps() >= config.MaxTraps
+	for i, def := range data.TrapDefs {
+		if i >= len(battle.BtnTraps) {
+			break
+		}
+		btn := &battle.BtnTraps[i]
+		btn.X = float64(trapBtnX + i*(trapBtnW+6))
+		btn.Y = shopY + 6
+		btn.W = trapBtnW
+		btn.H = 28
+		btn.Label = fmt.Sprintf("%s $%d", def.Name, def.Cost)
+		btn.Color = def.Color
+		if battle.PlacingTrap == def {
+			btn.Label = "PLACE..."
+		}
+		btn.Disabled = full || !battle.Shop.CanBuyTrap(def)
+		btn.Draw(screen, tick)
+	}
+	label := fmt.Sprintf("TRAPS %d/%d  (PATH TILES)", battle.BoughtTraps(), config.MaxTraps)
+	ui.DrawText(screen, label, ui.FontRegular(7), trapBtnX+110, shopY+42, config.ColorWhiteDim)
+}
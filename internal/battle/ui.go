@@ -4,12 +4,15 @@ import (
 	"fmt"
 	"image/color"
 	"math"
+	"strings"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/vector"
 
 	"neonsigil/internal/config"
 	"neonsigil/internal/data"
+	"neonsigil/internal/entity"
+	"neonsigil/internal/shop"
 	"neonsigil/internal/ui"
 )
 
@@ -36,6 +39,20 @@ func DrawHUD(screen *ebiten.Image, battle *BattleState, tick int) {
 	lvStr := fmt.Sprintf("LV.%d [%d/%d]", battle.Shop.Level, deployed, battle.Shop.DeployCap)
 	ui.DrawText(screen, lvStr, ui.FontBold(11), 680, 14, config.ColorNeonGreen)
 
+	// XP progress toward the next level
+	if battle.Shop.Rules.LevelUpEnabled {
+		xpX, xpY, xpW := float32(778), float32(14), float32(78)
+		vector.DrawFilledRect(screen, xpX, xpY+4, xpW, 6, color.RGBA{30, 30, 50, 200}, false)
+		xpStr := "MAX"
+		if need := battle.Shop.XPNeeded(); need > 0 {
+			vector.DrawFilledRect(screen, xpX, xpY+4, xpW*float32(battle.Shop.XP)/float32(need), 6, config.ColorNeonGreen, false)
+			xpStr = fmt.Sprintf("%d/%d XP", battle.Shop.XP, need)
+		} else {
+			vector.DrawFilledRect(screen, xpX, xpY+4, xpW, 6, config.ColorNeonGreen, false)
+		}
+		ui.DrawText(screen, xpStr, ui.FontRegular(6), float64(xpX), float64(xpY)-6, config.ColorWhiteDim)
+	}
+
 	// Integrity
 	intStr := fmt.Sprintf("INTEGRITY %d", battle.Integrity)
 	intColor := config.ColorNeonCyan
@@ -64,6 +81,49 @@ func DrawHUD(screen *ebiten.Image, battle *BattleState, tick int) {
 	}
 	vector.DrawFilledRect(screen, barX, barY, barW*ratio, barH, barColor, false)
 	vector.StrokeRect(screen, barX, barY, barW, barH, 1, color.RGBA{0, 200, 255, 100}, false)
+
+	drawBarrierStatus(screen, battle, tick)
+
+	if boss := battle.ActiveBoss(); boss != nil {
+		drawBossBar(screen, boss, tick)
+	}
+}
+
+// drawBossBar draws the boss HP bar with phase threshold markers along the bottom of the HUD
+func drawBossBar(screen *ebiten.Image, boss *entity.Enemy, tick int) {
+	barX := float32(360)
+	barY := float32(31)
+	barW := float32(560)
+	barH := float32(7)
+
+	vector.DrawFilledRect(screen, barX, barY, barW, barH, color.RGBA{40, 10, 20, 220}, false)
+	ratio := float32(boss.HP / boss.MaxHP)
+	vector.DrawFilledRect(screen, barX, barY, barW*ratio, barH, boss.Def.Color, false)
+	if boss.Shield > 0 {
+		shieldW := min(barW*float32(boss.Shield/boss.MaxHP), barW)
+		vector.DrawFilledRect(screen, barX, barY, shieldW, barH, color.RGBA{200, 230, 255, 160}, false)
+	}
+
+	// Phase markers: bright while upcoming, dim once entered
+	for i, ph := range boss.Def.Boss.Phases {
+		mx := barX + barW*float32(ph.HPPct)
+		mc := config.ColorWhite
+		if i < boss.PhaseIdx {
+			mc = color.RGBA{90, 90, 110, 200}
+		}
+		vector.DrawFilledRect(screen, mx-1, barY-2, 2, barH+4, mc, false)
+	}
+	vector.StrokeRect(screen, barX, barY, barW, barH, 1, config.WithAlpha(boss.Def.Color, 160), false)
+
+	label := boss.Def.Name
+	if boss.PhaseIdx > 0 {
+		label += " // " + boss.Def.Boss.Phases[boss.PhaseIdx-1].Name
+	}
+	labelClr := boss.Def.Color
+	if boss.BurstTimer > 0 && tick%20 < 10 {
+		labelClr = config.ColorNeonYellow
+	}
+	ui.DrawText(screen, label, ui.FontBold(8), float64(barX+barW+10), float64(barY)-2, labelClr)
 }
 
 // DrawShopUI draws the shop panel at the bottom
@@ -122,7 +182,45 @@ func DrawShopUI(screen *ebiten.Image, battle *BattleState, tick int) {
 		// Stats mini
 		statStr := fmt.Sprintf("ATK:%d RNG:%d", int(def.ATK), def.Range)
 		ui.DrawText(screen, statStr, ui.FontRegular(7), float64(slotX)+6, float64(slotY)+38, config.ColorWhiteDim)
+
+		// Copies left in the shared pool
+		poolStr := fmt.Sprintf("x%d", battle.Shop.Remaining(def))
+		ui.DrawText(screen, poolStr, ui.FontRegular(7), float64(slotX)+float64(slotW)-28, float64(slotY)+38, config.ColorWhiteDim)
+
+		// TRI-FUSE preview: buying this copy would fuse
+		if fuse := battle.FusionPreview(def); fuse > 0 {
+			pulse := uint8(150 + 100*math.Sin(float64(tick)*0.15))
+			vector.StrokeRect(screen, slotX+1, slotY+1, slotW-2, slotH-2, 1, config.WithAlpha(config.ColorNeonYellow, pulse), false)
+			fuseStr := "FUSE " + strings.Repeat("*", fuse)
+			ui.DrawText(screen, fuseStr, ui.FontBold(7), float64(slotX)+72, float64(slotY)+38, config.ColorNeonYellow)
+		}
+
+		// Frozen or locked slots keep their unit through refreshes
+		if battle.Shop.Frozen[i] {
+			vector.StrokeRect(screen, slotX-2, slotY-2, slotW+4, slotH+4, 2, config.ColorNeonCyan, false)
+			ui.DrawText(screen, "FROZEN", ui.FontRegular(6), float64(slotX)+float64(slotW)-38, float64(slotY)+24, config.ColorNeonCyan)
+		} else if battle.Shop.Locked {
+			vector.StrokeRect(screen, slotX-2, slotY-2, slotW+4, slotH+4, 1, config.ColorGold, false)
+		}
+	}
+
+	// Shop lock toggle
+	battle.BtnLock.X = 850
+	battle.BtnLock.Y = float64(shopY) + 6
+	battle.BtnLock.W = 110
+	battle.BtnLock.H = 28
+	battle.BtnLock.Label = "LOCK [L]"
+	battle.BtnLock.Color = config.ColorWhiteDim
+	if battle.Shop.Locked {
+		battle.BtnLock.Label = "LOCKED"
+		battle.BtnLock.Color = config.ColorGold
 	}
+	battle.BtnLock.Disabled = false
+	battle.BtnLock.Draw(screen, tick)
+	ui.DrawText(screen, "RIGHT-CLICK A SLOT TO FREEZE", ui.FontRegular(7), 850, float64(shopY)+42, config.ColorWhiteDim)
+
+	// Trap shop
+	drawTrapShop(screen, battle, tick, float64(shopY))
 
 	// Buttons area
 	btnY := float64(shopY) + 68
@@ -138,15 +236,64 @@ func DrawShopUI(screen *ebiten.Image, battle *BattleState, tick int) {
 	battle.BtnReroll.Disabled = !battle.Shop.CanReroll()
 	battle.BtnReroll.Draw(screen, tick)
 
-	// Level Up button
-	battle.BtnLevelUp.X = 210
-	battle.BtnLevelUp.Y = btnY
-	battle.BtnLevelUp.W = 140
-	battle.BtnLevelUp.H = btnH
-	battle.BtnLevelUp.Label = fmt.Sprintf("LEVEL UP $%d", battle.Shop.LevelUpCost())
-	battle.BtnLevelUp.Color = config.ColorNeonGreen
-	battle.BtnLevelUp.Disabled = !battle.Shop.CanLevelUp()
-	battle.BtnLevelUp.Draw(screen, tick)
+	// Buy XP button
+	battle.BtnBuyXP.X = 210
+	battle.BtnBuyXP.Y = btnY
+	battle.BtnBuyXP.W = 140
+	battle.BtnBuyXP.H = btnH
+	battle.BtnBuyXP.Label = fmt.Sprintf("+%d XP $%d", battle.Shop.XPBuyAmount(), battle.Shop.XPBuyCost())
+	battle.BtnBuyXP.Color = config.ColorNeonGreen
+	battle.BtnBuyXP.Disabled = !battle.Shop.CanBuyXP()
+	battle.BtnBuyXP.Draw(screen, tick)
+
+	// Barrier button
+	if def := battle.Barrier(); def != nil {
+		battle.BtnBarrier.X = 560
+		battle.BtnBarrier.Y = btnY
+		battle.BtnBarrier.W = 160
+		battle.BtnBarrier.H = btnH
+		battle.BtnBarrier.Label = "BARRIER [B]"
+		if battle.BarrierAiming {
+			battle.BtnBarrier.Label = "PICK TARGET"
+		} else if !battle.BarrierReady() && battle.BarrierCooldown <= 0 && battle.NodeNetworkComplete() {
+			battle.BtnBarrier.Label = fmt.Sprintf("CHARGING %d%%", int(battle.BarrierCharge*100))
+		}
+		battle.BtnBarrier.Color = config.ColorNeonBlue
+		battle.BtnBarrier.Disabled = !battle.BarrierReady()
+		battle.BtnBarrier.Draw(screen, tick)
+	}
+
+	// Buy component button
+	if battle.ItemsEnabled() {
+		battle.BtnBuyItem.X = 740
+		battle.BtnBuyItem.Y = btnY
+		battle.BtnBuyItem.W = 120
+		battle.BtnBuyItem.H = btnH
+		battle.BtnBuyItem.Label = fmt.Sprintf("PART $%d", config.ComponentCost)
+		battle.BtnBuyItem.Color = config.ColorNeonOrange
+		battle.BtnBuyItem.Disabled = !battle.Shop.CanBuyComponent() || len(battle.Inventory) >= config.InventorySlots
+		battle.BtnBuyItem.Draw(screen, tick)
+	}
+
+	// Pool viewer toggle
+	battle.BtnPool.X = 880
+	battle.BtnPool.Y = btnY
+	battle.BtnPool.W = 100
+	battle.BtnPool.H = btnH
+	battle.BtnPool.Label = "POOL [P]"
+	battle.BtnPool.Color = config.ColorNeonPurple
+	battle.BtnPool.Disabled = false
+	battle.BtnPool.Draw(screen, tick)
+
+	// Gold ledger toggle
+	battle.BtnLedger.X = 990
+	battle.BtnLedger.Y = btnY
+	battle.BtnLedger.W = 80
+	battle.BtnLedger.H = btnH
+	battle.BtnLedger.Label = "LOG [G]"
+	battle.BtnLedger.Color = config.ColorGold
+	battle.BtnLedger.Disabled = false
+	battle.BtnLedger.Draw(screen, tick)
 
 	// Start Wave button
 	if !battle.WaveMgr.WaveActive {
@@ -166,7 +313,7 @@ func DrawShopUI(screen *ebiten.Image, battle *BattleState, tick int) {
 		battle.BtnSell.Y = btnY
 		battle.BtnSell.W = 120
 		battle.BtnSell.H = btnH
-		refund := max(1, battle.SelectedUnit.Def.Cost/2)
+		refund := shop.SellPrice(battle.SelectedUnit)
 		battle.BtnSell.Label = fmt.Sprintf("SELL $%d", refund)
 		battle.BtnSell.Color = config.ColorNeonRed
 		battle.BtnSell.Disabled = false
@@ -180,6 +327,11 @@ func DrawBenchUI(screen *ebiten.Image, battle *BattleState, tick int) {
 
 	// Bench label
 	ui.DrawText(screen, "BENCH", ui.FontRegular(9), float64(config.BoardOffsetX), float64(benchY)-12, config.ColorWhiteDim)
+	formationHint := "F5 SAVE / F9 LOAD FORMATION"
+	if battle.HasFormation() {
+		formationHint += " (SAVED)"
+	}
+	ui.DrawText(screen, formationHint, ui.FontRegular(7), float64(config.BoardOffsetX)+60, float64(benchY)-11, config.ColorWhiteDim)
 
 	// Bench slots
 	for i := 0; i < config.BenchSlots; i++ {
@@ -198,6 +350,56 @@ func DrawBenchUI(screen *ebiten.Image, battle *BattleState, tick int) {
 			u.DrawOnBench(screen, u.BenchSlot, tick)
 		}
 	}
+
+	if battle.ItemsEnabled() {
+		drawInventory(screen, battle, tick)
+	}
+}
+
+// drawInventory draws the item inventory next to the bench
+func drawInventory(screen *ebiten.Image, battle *BattleState, tick int) {
+	iy := float32(config.BenchSlotY())
+	s := float32(inventorySlotSize)
+
+	label := "ITEMS"
+	if battle.CanCraft() {
+		label = "ITEMS  (WORKBENCH MANNED: CLICK TWO PARTS TO CRAFT)"
+	}
+	if sel := battle.SelectedItem; sel >= 0 && sel < len(battle.Inventory) {
+		it := battle.Inventory[sel]
+		label = fmt.Sprintf("%s  %s", it.Name, itemSummary(it))
+		if it.Consumable {
+			label += "  (CLICK A PATH TILE)"
+		}
+	}
+	ui.DrawText(screen, label, ui.FontRegular(9), float64(inventoryX), float64(iy)-12, config.ColorWhiteDim)
+
+	for i := 0; i < battle.inventoryLen(); i++ {
+		ix := float32(inventorySlotX(i))
+		vector.DrawFilledRect(screen, ix, iy, s, s, color.RGBA{18, 18, 35, 200}, false)
+		if i >= len(battle.Inventory) {
+			vector.StrokeRect(screen, ix, iy, s, s, 1, color.RGBA{40, 40, 70, 150}, false)
+			continue
+		}
+
+		it := battle.Inventory[i]
+		vector.StrokeRect(screen, ix, iy, s, s, 1.5, it.Color, false)
+		switch {
+		case it.Component:
+			vector.DrawFilledCircle(screen, ix+s/2, iy+s/2-4, 6, it.Color, false)
+		case it.Consumable:
+			vector.StrokeCircle(screen, ix+s/2, iy+s/2-4, 8, 2, it.Color, false)
+			vector.DrawFilledCircle(screen, ix+s/2, iy+s/2-4, 3, it.Color, false)
+		default:
+			vector.DrawFilledRect(screen, ix+s/2-8, iy+s/2-12, 16, 16, it.Color, false)
+		}
+		ui.DrawTextCentered(screen, it.ID, ui.FontRegular(6), float64(ix+s/2), float64(iy+s)-10, config.ColorWhiteDim)
+
+		if i == battle.SelectedItem {
+			pulse := uint8(150 + 100*math.Sin(float64(tick)*0.1))
+			vector.StrokeRect(screen, ix-2, iy-2, s+4, s+4, 2, color.RGBA{255, 255, 255, pulse}, false)
+		}
+	}
 }
 
 // DrawInfoPanel draws the right-side info panel
@@ -296,14 +498,109 @@ func DrawInfoPanel(screen *ebiten.Image, battle *BattleState, tick int) {
 		ui.DrawText(screen, tagStr, ui.FontRegular(9), panelX+12, y, config.ColorWhiteDim)
 		y += 22
 
-		ui.DrawText(screen, fmt.Sprintf("ATK  %d", int(u.ATK)), ui.FontRegular(10), panelX+12, y, config.ColorNeonRed)
-		ui.DrawText(screen, fmt.Sprintf("SPD  %.1f", u.AtkSpeed), ui.FontRegular(10), panelX+120, y, config.ColorNeonCyan)
+		// Equipped items in the right column
+		if battle.ItemsEnabled() || len(u.Items) > 0 {
+			iy := y
+			ui.DrawText(screen, fmt.Sprintf("ITEMS %d/%d", len(u.Items), config.ItemSlots), ui.FontBold(10), panelX+360, iy, config.ColorNeonOrange)
+			iy += 18
+			for _, it := range u.Items {
+				vector.DrawFilledRect(screen, float32(panelX)+360, float32(iy)+2, 6, 6, it.Color, false)
+				ui.DrawText(screen, it.Name, ui.FontRegular(9), panelX+372, iy, it.Color)
+				ui.DrawText(screen, itemSummary(it), ui.FontRegular(7), panelX+372, iy+13, config.ColorWhiteDim)
+				iy += 30
+			}
+		}
+
+		// Damage statistics in the far column
+		sy := y
+		ui.DrawText(screen, "DAMAGE", ui.FontBold(10), panelX+560, sy, config.ColorNeonRed)
+		sy += 18
+		st := u.Stats
+		for _, line := range []string{
+			fmt.Sprintf("DEALT     %d", int(st.Dealt)),
+			fmt.Sprintf("OVERKILL  %d", int(st.Overkill)),
+			fmt.Sprintf("WASTED    %d", int(st.Wasted)),
+			fmt.Sprintf("FIZZLED %d  RETARGET %d", st.Fizzled, st.Retargeted),
+		} {
+			ui.DrawText(screen, line, ui.FontRegular(8), panelX+560, sy, config.ColorWhiteDim)
+			sy += 14
+		}
+
+		ui.DrawText(screen, fmt.Sprintf("ATK  %d", int(u.EffectiveATK())), ui.FontRegular(10), panelX+12, y, config.ColorNeonRed)
+		ui.DrawText(screen, fmt.Sprintf("SPD  %.1f", u.EffectiveAtkSpeed()), ui.FontRegular(10), panelX+120, y, config.ColorNeonCyan)
 		y += 18
-		ui.DrawText(screen, fmt.Sprintf("RNG  %d", u.Range), ui.FontRegular(10), panelX+12, y, config.ColorNeonGreen)
+		ui.DrawText(screen, fmt.Sprintf("RNG  %d", u.EffectiveRange()), ui.FontRegular(10), panelX+12, y, config.ColorNeonGreen)
 		ui.DrawText(screen, fmt.Sprintf("ARM  %d", int(u.Def.Armor)), ui.FontRegular(10), panelX+120, y, config.ColorNeonYellow)
+		y += 18
+		if u.CanTargetAir() {
+			ui.DrawText(screen, fmt.Sprintf("AIR  x%.2f", u.EffectiveAirMul()), ui.FontRegular(10), panelX+12, y, config.ColorNeonPurple)
+		} else {
+			ui.DrawText(screen, "AIR  --", ui.FontRegular(10), panelX+12, y, color.RGBA{60, 60, 80, 200})
+		}
+
+		// Targeting priority, click to cycle
+		battle.BtnTargeting.X = panelX + 120
+		battle.BtnTargeting.Y = y - 3
+		battle.BtnTargeting.W = 150
+		battle.BtnTargeting.H = 18
+		battle.BtnTargeting.Label = string(u.Targeting) + " >"
+		battle.BtnTargeting.Color = config.ColorWhiteDim
+		battle.BtnTargeting.Disabled = false
+		battle.BtnTargeting.Draw(screen, tick)
 		y += 22
 
 		ui.DrawText(screen, u.Def.SkillDesc, ui.FontRegular(8), panelX+12, y, config.ColorNeonMagenta)
+
+		// Traits and active status on one compact line
+		var traits []string
+		if pd := u.Def.Projectile; pd != nil {
+			switch pd.Kind {
+			case config.ProjSplash:
+				traits = append(traits, fmt.Sprintf("SPLASH %.1f", pd.Radius))
+			case config.ProjPierce:
+				traits = append(traits, fmt.Sprintf("PIERCE %d", pd.Pierce))
+			case config.ProjChain:
+				traits = append(traits, fmt.Sprintf("CHAIN x%d", pd.Chains))
+			default:
+				traits = append(traits, string(pd.Kind))
+			}
+			if pd.OnHit != nil {
+				traits = append(traits, string(pd.OnHit.Kind))
+			}
+		}
+		if sd := u.Def.Summon; sd != nil {
+			trait := fmt.Sprintf("%s x%d /%.0fs", sd.Name, max(sd.Max, 1), sd.Cooldown)
+			if n := battle.summonCount(u); n > 0 {
+				trait += fmt.Sprintf(" (%d UP)", n)
+			}
+			traits = append(traits, trait)
+		}
+		if ts := u.Def.TrapSkill; ts != nil {
+			traits = append(traits, fmt.Sprintf("LAYS %s /%.0fs", ts.Trap, ts.Cooldown))
+		}
+		if u.Def.DetectRange > 0 {
+			traits = append(traits, fmt.Sprintf("DETECT %d", u.Def.DetectRange))
+		}
+		if resist := data.DisruptResist[u.Def.Faction]; resist > 0 {
+			traits = append(traits, fmt.Sprintf("HACK-RES %d%%", int(resist*100)))
+		}
+		if u.SilenceTimer > 0 {
+			traits = append(traits, fmt.Sprintf("SILENCED %.1fs", u.SilenceTimer))
+		}
+		if u.AtkSlowTimer > 0 {
+			traits = append(traits, fmt.Sprintf("SLOWED %.1fs", u.AtkSlowTimer))
+		}
+		if u.JamTimer > 0 {
+			traits = append(traits, fmt.Sprintf("JAMMED %.1fs", u.JamTimer))
+		}
+		if len(traits) > 0 {
+			y += 14
+			clr := config.ColorNeonGreen
+			if u.IsDisrupted() {
+				clr = config.ColorNeonRed
+			}
+			ui.DrawText(screen, strings.Join(traits, "  "), ui.FontRegular(7), panelX+12, y, clr)
+		}
 	}
 
 	// Wave preview
@@ -313,41 +610,329 @@ func DrawInfoPanel(screen *ebiten.Image, battle *BattleState, tick int) {
 			color.RGBA{60, 60, 100, 100}, false)
 		y += 12
 		ui.DrawText(screen, "NEXT WAVE", ui.FontBold(10), panelX+12, y, config.ColorNeonCyan)
+		wv := battle.Stage.Waves[battle.WaveMgr.CurrentWave]
+		if mods := waveModifiers(wv); mods != "" {
+			ui.DrawText(screen, mods, ui.FontRegular(8), panelX+100, y+1, config.ColorGold)
+		}
 		y += 20
 
-		wv := battle.Stage.Waves[battle.WaveMgr.CurrentWave]
-		for _, g := range wv.Groups {
+		for i, g := range wv.Groups {
 			def := data.EnemyDefs[g.Enemy]
 			if def != nil {
-				eStr := fmt.Sprintf("%s x%d", def.Name, g.Count)
+				eStr := fmt.Sprintf("%d %s x%d", i+1, def.Name, g.Count)
 				ui.DrawText(screen, eStr, ui.FontRegular(9), panelX+16, y, def.Color)
+				if sched := groupSchedule(g); sched != "" {
+					ui.DrawText(screen, sched, ui.FontRegular(7), panelX+150, y+1, config.ColorWhite)
+				}
 				y += 16
 			}
 		}
 	}
 }
 
-// DrawNodeIndicator draws node connection lines when 3 nodes occupied
+// waveModifiers describes a wave's HP/speed multipliers and bonus gold
+func waveModifiers(wv data.WaveDef) string {
+	var parts []string
+	if wv.HPMul > 0 && wv.HPMul != 1 {
+		parts = append(parts, fmt.Sprintf("HP x%.2g", wv.HPMul))
+	}
+	if wv.SpdMul > 0 && wv.SpdMul != 1 {
+		parts = append(parts, fmt.Sprintf("SPD x%.2g", wv.SpdMul))
+	}
+	if wv.Gold > 0 {
+		parts = append(parts, fmt.Sprintf("+%dG", wv.Gold))
+	}
+	return strings.Join(parts, "  ")
+}
+
+// groupSchedule describes when and how a wave group spawns
+func groupSchedule(g data.WaveGroup) string {
+	var parts []string
+	if g.After > 0 {
+		parts = append(parts, fmt.Sprintf("AFTER %d", g.After))
+	}
+	if g.Delay > 0 {
+		parts = append(parts, fmt.Sprintf("+%.0fs", g.Delay))
+	}
+	if g.Formation != "" && g.Formation != config.FormSingle {
+		size := g.Size
+		if size <= 0 {
+			size = config.DefaultFormationSize
+		}
+		parts = append(parts, fmt.Sprintf("%s %d", g.Formation, size))
+	}
+	return strings.Join(parts, " ")
+}
+
+// DrawPoolViewer lists the copies left in the shared unit pool, by cost,
+// drawn over the info panel
+func DrawPoolViewer(screen *ebiten.Image, battle *BattleState) {
+	panelX := float64(config.BoardOffsetX + config.BoardCols*config.TileSize + 20)
+	panelY := float64(config.BoardOffsetY)
+	panelW := float64(config.ScreenWidth) - panelX - 20
+	panelH := float64(config.ScreenHeight - 230)
+
+	vector.DrawFilledRect(screen, float32(panelX), float32(panelY), float32(panelW), float32(panelH),
+		color.RGBA{8, 8, 20, 245}, false)
+	vector.StrokeRect(screen, float32(panelX), float32(panelY), float32(panelW), float32(panelH),
+		1, config.ColorNeonPurple, false)
+
+	ui.DrawText(screen, "UNIT POOL", ui.FontBold(11), panelX+12, panelY+15, config.ColorNeonPurple)
+
+	colW := (panelW - 24) / 4
+	for cost := 1; cost <= 4; cost++ {
+		x := panelX + 12 + float64(cost-1)*colW
+		y := panelY + 45
+		total := data.PoolCopies[cost]
+		allowed := false
+		for _, c := range battle.Shop.Rules.AllowedCosts {
+			if c == cost {
+				allowed = true
+			}
+		}
+		hdrClr := config.ColorGold
+		if !allowed {
+			hdrClr = color.RGBA{60, 60, 80, 200}
+		}
+		ui.DrawText(screen, fmt.Sprintf("$%d  (%d EACH)", cost, total), ui.FontBold(10), x, y, hdrClr)
+		y += 24
+
+		for _, def := range data.GetUnitsForCost(cost) {
+			left := battle.Shop.Remaining(def)
+			clr := config.FactionColors[def.Faction]
+			if left == 0 || !allowed {
+				clr = color.RGBA{60, 60, 80, 200}
+			}
+			ui.DrawText(screen, def.Name, ui.FontRegular(9), x, y, clr)
+			ui.DrawText(screen, fmt.Sprintf("%d/%d", left, total), ui.FontRegular(9), x+80, y, config.ColorWhiteDim)
+
+			// Remaining copies bar
+			barW := float32(colW - 30)
+			vector.DrawFilledRect(screen, float32(x), float32(y)+14, barW, 3, color.RGBA{30, 30, 50, 200}, false)
+			if total > 0 {
+				vector.DrawFilledRect(screen, float32(x), float32(y)+14, barW*float32(left)/float32(total), 3, clr, false)
+			}
+			y += 26
+		}
+	}
+}
+
+// DrawLedgerPanel shows per-wave income and expenses and the latest gold
+// transactions, drawn over the info panel
+func DrawLedgerPanel(screen *ebiten.Image, battle *BattleState) {
+	panelX := float64(config.BoardOffsetX + config.BoardCols*config.TileSize + 20)
+	panelY := float64(config.BoardOffsetY)
+	panelW := float64(config.ScreenWidth) - panelX - 20
+	panelH := float64(config.ScreenHeight - 230)
+
+	vector.DrawFilledRect(screen, float32(panelX), float32(panelY), float32(panelW), float32(panelH),
+		color.RGBA{8, 8, 20, 245}, false)
+	vector.StrokeRect(screen, float32(panelX), float32(panelY), float32(panelW), float32(panelH),
+		1, config.ColorGold, false)
+
+	ledger := &battle.Shop.Ledger
+	ui.DrawText(screen, "GOLD LEDGER", ui.FontBold(11), panelX+12, panelY+15, config.ColorGold)
+
+	// Per-wave totals
+	face := ui.FontRegular(9)
+	x := panelX + 12
+	y := panelY + 45
+	ui.DrawText(screen, "WAVE   INCOME  EXPENSE   NET", ui.FontBold(9), x, y, config.ColorWhiteDim)
+	y += 20
+	for _, w := range ledger.Summaries() {
+		netClr := config.ColorNeonGreen
+		if w.Net() < 0 {
+			netClr = config.ColorNeonRed
+		}
+		ui.DrawText(screen, fmt.Sprintf("%-4d", w.Wave), face, x, y, config.ColorWhite)
+		ui.DrawText(screen, fmt.Sprintf("+%d", w.Income), face, x+56, y, config.ColorNeonGreen)
+		ui.DrawText(screen, fmt.Sprintf("-%d", w.Expense), face, x+120, y, config.ColorNeonRed)
+		ui.DrawText(screen, fmt.Sprintf("%+d", w.Net()), face, x+190, y, netClr)
+		y += 16
+		if y > panelY+panelH-20 {
+			break
+		}
+	}
+
+	// Current wave breakdown by reason
+	x = panelX + 280
+	y = panelY + 45
+	ui.DrawText(screen, fmt.Sprintf("WAVE %d BY SOURCE", ledger.Wave), ui.FontBold(9), x, y, config.ColorWhiteDim)
+	y += 20
+	totals := ledger.ByReason(ledger.Wave)
+	for _, r := range []config.GoldReason{config.GoldKill, config.GoldWave, config.GoldInterest, config.GoldStreak, config.GoldSell,
+		config.GoldBuyUnit, config.GoldReroll, config.GoldBuyXP, config.GoldBuyPart, config.GoldBuyTrap, config.GoldRefund} {
+		amt, ok := totals[r]
+		if !ok {
+			continue
+		}
+		clr := config.ColorNeonGreen
+		if amt < 0 {
+			clr = config.ColorNeonRed
+		}
+		ui.DrawText(screen, string(r), face, x, y, config.ColorWhite)
+		ui.DrawText(screen, fmt.Sprintf("%+d", amt), face, x+90, y, clr)
+		y += 16
+	}
+
+	// Latest transactions, newest first
+	x = panelX + 480
+	y = panelY + 45
+	ui.DrawText(screen, "RECENT", ui.FontBold(9), x, y, config.ColorWhiteDim)
+	y += 20
+	for i := len(ledger.Entries) - 1; i >= 0 && y < panelY+panelH-20; i-- {
+		t := ledger.Entries[i]
+		clr := config.ColorNeonGreen
+		if t.Amount < 0 {
+			clr = config.ColorNeonRed
+		}
+		ui.DrawText(screen, fmt.Sprintf("W%d %5.1fs", t.Wave, float64(t.Tick)/60), ui.FontRegular(7), x, y+1, config.ColorWhiteDim)
+		ui.DrawText(screen, string(t.Reason), face, x+70, y, config.ColorWhite)
+		ui.DrawText(screen, fmt.Sprintf("%+d", t.Amount), face, x+160, y, clr)
+		y += 15
+	}
+}
+
+// DrawIncomeTooltip shows what the next wave will pay, above the START WAVE button
+func DrawIncomeTooltip(screen *ebiten.Image, battle *BattleState) {
+	econ := battle.Economy
+	wave := battle.WaveMgr.CurrentWave + 1
+	gold := battle.Shop.Gold
+	clean := econ.Preview(wave, gold, true)
+	leak := econ.Preview(wave, gold, false)
+
+	w, h := float32(230), float32(112)
+	if clean.Reward > 0 {
+		h += 14
+	}
+	x := float32(battle.BtnStartWave.X+battle.BtnStartWave.W) - w
+	y := float32(battle.BtnStartWave.Y) - h - 8
+	vector.DrawFilledRect(screen, x, y, w, h, color.RGBA{8, 8, 20, 235}, false)
+	vector.StrokeRect(screen, x, y, w, h, 1, config.ColorGold, false)
+
+	tx := float64(x) + 10
+	ty := float64(y) + 6
+	face := ui.FontRegular(8)
+	ui.DrawText(screen, "WAVE INCOME", ui.FontBold(10), tx, ty, config.ColorGold)
+	ty += 18
+	ui.DrawText(screen, fmt.Sprintf("BASE      +%d", clean.Base), face, tx, ty, config.ColorWhite)
+	ty += 14
+	interest := fmt.Sprintf("INTEREST  +%d", clean.Interest)
+	if econ.Def.InterestStep > 0 {
+		interest += fmt.Sprintf("  (1 per %d, max %d)", econ.Def.InterestStep, econ.Def.InterestCap)
+	}
+	ui.DrawText(screen, interest, face, tx, ty, config.ColorWhite)
+	ty += 14
+	ui.DrawText(screen, fmt.Sprintf("NO LEAKS  +%d  (streak %d)", clean.Streak, econ.WinStreak), face, tx, ty, config.ColorNeonGreen)
+	ty += 14
+	ui.DrawText(screen, fmt.Sprintf("LEAKED    +%d  (streak %d)", leak.Streak, econ.LossStreak), face, tx, ty, config.ColorNeonRed)
+	if clean.Reward > 0 {
+		ty += 14
+		ui.DrawText(screen, fmt.Sprintf("REWARD    +%d", clean.Reward), face, tx, ty, config.ColorGold)
+	}
+	ty += 18
+	ui.DrawText(screen, fmt.Sprintf("TOTAL +%d / +%d", clean.Total(), leak.Total()), ui.FontBold(9), tx, ty, config.ColorGold)
+}
+
+// DrawTileTooltip explains the special tile under the cursor
+func DrawTileTooltip(screen *ebiten.Image, battle *BattleState) {
+	mx, my := battle.CursorX, battle.CursorY
+	if mx < config.BoardOffsetX || my < config.BoardOffsetY {
+		return
+	}
+	gx, gy := battle.Board.ScreenToGrid(mx, my)
+	if gx >= config.BoardCols || gy >= config.BoardRows {
+		return
+	}
+	def := battle.Board.SpecialAt(gx, gy)
+	if def == nil {
+		return
+	}
+
+	face := ui.FontRegular(8)
+	descW, _ := ui.MeasureText(def.Desc, face)
+	w := float32(descW) + 20
+	h := float32(38)
+	x := float32(mx) + 14
+	y := float32(my) + 14
+	if x+w > config.ScreenWidth {
+		x = float32(mx) - w - 6
+	}
+
+	vector.DrawFilledRect(screen, x, y, w, h, color.RGBA{8, 8, 20, 235}, false)
+	vector.StrokeRect(screen, x, y, w, h, 1, config.ColorNeonCyan, false)
+	ui.DrawText(screen, def.Name, ui.FontBold(10), float64(x)+10, float64(y)+6, config.ColorNeonCyan)
+	ui.DrawText(screen, def.Desc, face, float64(x)+10, float64(y)+22, config.ColorWhiteDim)
+}
+
+// DrawNodeIndicator draws the node network: links between occupied nodes,
+// closed into a loop once enough nodes are occupied to power the barrier
 func DrawNodeIndicator(screen *ebiten.Image, battle *BattleState, tick int) {
-	if !battle.Stage.NodesEnabled {
+	if battle.Barrier() == nil {
 		return
 	}
 
 	occupiedNodes := battle.GetOccupiedNodes()
-	if len(occupiedNodes) < 3 {
+	if len(occupiedNodes) < 2 {
 		return
 	}
 
-	// Draw triangle between first 3 occupied nodes
+	complete := battle.NodeNetworkComplete()
 	pulse := math.Sin(float64(tick%60)/60.0*math.Pi*2)*0.4 + 0.6
 	alpha := uint8(float64(80) * pulse)
+	width := float32(3)
+	if !complete {
+		alpha = 35
+		width = 1
+	}
 
-	for i := 0; i < len(occupiedNodes) && i < 3; i++ {
-		next := (i + 1) % min(len(occupiedNodes), 3)
+	links := len(occupiedNodes) - 1
+	if complete && len(occupiedNodes) >= 3 {
+		links = len(occupiedNodes)
+	}
+	for i := 0; i < links; i++ {
+		next := (i + 1) % len(occupiedNodes)
 		x1 := float32(config.BoardOffsetX+occupiedNodes[i].X*config.TileSize) + float32(config.TileSize)/2
 		y1 := float32(config.BoardOffsetY+occupiedNodes[i].Y*config.TileSize) + float32(config.TileSize)/2
 		x2 := float32(config.BoardOffsetX+occupiedNodes[next].X*config.TileSize) + float32(config.TileSize)/2
 		y2 := float32(config.BoardOffsetY+occupiedNodes[next].Y*config.TileSize) + float32(config.TileSize)/2
-		vector.StrokeLine(screen, x1, y1, x2, y2, 3, color.RGBA{0, 180, 255, alpha}, false)
+		vector.StrokeLine(screen, x1, y1, x2, y2, width, color.RGBA{0, 180, 255, alpha}, false)
+	}
+}
+
+// drawBarrierStatus draws the barrier name with its node count, cooldown or ready state
+func drawBarrierStatus(screen *ebiten.Image, battle *BattleState, tick int) {
+	def := battle.Barrier()
+	if def == nil {
+		return
+	}
+	x := float32(1145)
+	y := float32(6)
+	w := float32(120)
+
+	ui.DrawText(screen, def.Name, ui.FontBold(8), float64(x), float64(y), config.ColorNeonBlue)
+
+	barY := y + 14
+	vector.DrawFilledRect(screen, x, barY, w, 5, color.RGBA{30, 30, 50, 200}, false)
+	var status string
+	statusClr := config.ColorWhiteDim
+	switch {
+	case battle.BarrierCooldown > 0:
+		ratio := 1 - float32(battle.BarrierCooldown/def.Cooldown)
+		vector.DrawFilledRect(screen, x, barY, w*ratio, 5, config.ColorNeonBlue, false)
+		status = fmt.Sprintf("CD %.0fs", battle.BarrierCooldown)
+	case battle.BarrierReady():
+		vector.DrawFilledRect(screen, x, barY, w, 5, config.ColorNeonCyan, false)
+		status = "READY [B]"
+		statusClr = config.ColorNeonCyan
+		if tick%30 < 15 {
+			statusClr = config.ColorWhite
+		}
+	case battle.NodeNetworkComplete():
+		vector.DrawFilledRect(screen, x, barY, w*float32(battle.BarrierCharge), 5, config.ColorNeonCyan, false)
+		status = fmt.Sprintf("CHARGING %d%%", int(battle.BarrierCharge*100))
+	default:
+		status = fmt.Sprintf("NODES %d/%d", len(battle.GetOccupiedNodes()), battle.NodesRequired())
 	}
+	ui.DrawText(screen, status, ui.FontRegular(7), float64(x), float64(barY)+8, statusClr)
 }
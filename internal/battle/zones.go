@@ -0,0 +1,78 @@
+package battle
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"neonsigil/internal/config"
+	"neonsigil/internal/data"
+	"neonsigil/internal/entity"
+)
+
+// AddZone places a ground zone on a path tile, applying the def's stacking
+// rule against a zone of the same type already on that tile. Returns false
+// when the tile is not part of the path.
+func (b *BattleState) AddZone(def *data.ZoneDef, tile config.Pos, owner *entity.Unit) bool {
+	if def == nil || !b.Board.IsPath(tile.X, tile.Y) {
+		return false
+	}
+	z := entity.NewZone(def, tile, owner)
+	if def.Stacking != config.StackIndependent {
+		for _, other := range b.Zones {
+			if other.Alive && other.Def.ID == def.ID && other.Tile == tile {
+				other.Refresh(z)
+				return true
+			}
+		}
+	}
+	b.Zones = append(b.Zones, z)
+	return true
+}
+
+// UpdateZones recomputes the zone effects on every enemy, ticks the zones
+// and drops the expired ones. Effects only last while the enemy stands inside.
+func (b *BattleState) UpdateZones() {
+	for _, e := range b.Enemies {
+		e.Zone = entity.ZoneFx{}
+	}
+	alive := b.Zones[:0]
+	for _, z := range b.Zones {
+		z.Update(b.Enemies)
+		if z.Alive {
+			alive = append(alive, z)
+		}
+	}
+	b.Zones = alive
+}
+
+// spawnProjectileZones leaves the ground zone of every splash shot that
+// exploded this frame
+func (b *BattleState) spawnProjectileZones() {
+	for _, p := range b.Projectiles {
+		if p.ZoneAt == nil {
+			continue
+		}
+		gx, gy := b.Board.ScreenToGrid(int(p.ZoneAt.X), int(p.ZoneAt.Y))
+		b.AddZone(data.ZoneDefs[p.Def.Zone], config.Pos{X: gx, Y: gy}, p.Owner)
+		p.ZoneAt = nil
+	}
+}
+
+// UseSelectedItem throws the selected consumable onto a path tile
+func (b *BattleState) UseSelectedItem(gx, gy int) bool {
+	if b.SelectedItem < 0 || b.SelectedItem >= len(b.Inventory) {
+		return false
+	}
+	it := b.Inventory[b.SelectedItem]
+	if !it.Consumable || !b.AddZone(data.ZoneDefs[it.Zone], config.Pos{X: gx, Y: gy}, nil) {
+		return false
+	}
+	b.removeItem(b.SelectedItem)
+	return true
+}
+
+// drawZones renders every ground zone; drawn beneath all enemies
+func drawZones(screen *ebiten.Image, battle *BattleState, tick int) {
+	for _, z := range battle.Zones {
+		z.Draw(screen, tick)
+	}
+}
@@ -26,6 +26,11 @@ type Board struct {
 	Paths    map[config.Pos]bool // which tiles are path tiles
 	NodeSet  map[config.Pos]bool
 	PathDefs []data.PathDef
+
+	// Maze mode: ground paths only define spawn and exit, routes are found
+	// with A* around blocks and units (see Reroute)
+	Maze     bool
+	mazeEnds map[string][2]config.Pos
 }
 
 // NewBoard creates a new board from a stage definition
@@ -34,7 +39,9 @@ func NewBoard(stage *data.StageDef) *Board {
 		Specials: make(map[config.Pos]config.SpecialType),
 		Paths:    make(map[config.Pos]bool),
 		NodeSet:  make(map[config.Pos]bool),
-		PathDefs: stage.Paths,
+		PathDefs: append([]data.PathDef(nil), stage.Paths...),
+		Maze:     stage.Maze,
+		mazeEnds: make(map[string][2]config.Pos),
 	}
 
 	// Default all to BUILD
@@ -44,9 +51,17 @@ func NewBoard(stage *data.StageDef) *Board {
 		}
 	}
 
-	// Mark path tiles
+	// Mark path tiles (air lanes fly over the grid and leave tiles buildable)
 	for _, p := range stage.Paths {
-		for _, wp := range p.Waypoints {
+		if p.Air {
+			continue
+		}
+		waypoints := p.Waypoints
+		if b.Maze && len(waypoints) > 0 {
+			b.mazeEnds[p.ID] = [2]config.Pos{waypoints[0], waypoints[len(waypoints)-1]}
+			waypoints = []config.Pos{waypoints[0], waypoints[len(waypoints)-1]}
+		}
+		for _, wp := range waypoints {
 			if wp.X >= 0 && wp.X < config.BoardCols && wp.Y >= 0 && wp.Y < config.BoardRows {
 				b.Tiles[wp.X][wp.Y] = config.TilePath
 				b.Paths[wp] = true
@@ -78,6 +93,9 @@ func NewBoard(stage *data.StageDef) *Board {
 		}
 	}
 
+	// Maze routes start out around the stage blocks only
+	b.Reroute(nil)
+
 	return b
 }
 
@@ -90,6 +108,48 @@ func (b *Board) CanPlace(x, y int) bool {
 	return t == config.TileBuild || t == config.TileNode || t == config.TileSpecial
 }
 
+// IsPath reports whether the given grid position is on a ground route
+// (including the current maze routes)
+func (b *Board) IsPath(x, y int) bool {
+	return b.Paths[config.Pos{X: x, Y: y}]
+}
+
+// PathByID returns the path definition with the given ID, or nil if none exists
+func (b *Board) PathByID(id string) *data.PathDef {
+	for i := range b.PathDefs {
+		if b.PathDefs[i].ID == id {
+			return &b.PathDefs[i]
+		}
+	}
+	return nil
+}
+
+// StepsAfter returns the fewest waypoints left after the end of a path,
+// following its branches to the exit
+func (b *Board) StepsAfter(pathID string) int {
+	return b.stepsAfter(pathID, len(b.PathDefs))
+}
+
+// stepsAfter limits the depth so a looping path graph cannot recurse forever
+func (b *Board) stepsAfter(pathID string, depth int) int {
+	pd := b.PathByID(pathID)
+	if pd == nil || len(pd.Branches) == 0 || depth <= 0 {
+		return 0
+	}
+	best := -1
+	for _, br := range pd.Branches {
+		next := b.PathByID(br.PathID)
+		if next == nil {
+			continue
+		}
+		steps := len(next.Waypoints) - 1 + b.stepsAfter(br.PathID, depth-1)
+		if best < 0 || steps < best {
+			best = steps
+		}
+	}
+	return max(best, 0)
+}
+
 // TileScreenPos converts grid coordinates to screen pixel position
 func (b *Board) TileScreenPos(x, y int) (float64, float64) {
 	return float64(config.BoardOffsetX + x*config.TileSize), float64(config.BoardOffsetY + y*config.TileSize)
@@ -129,6 +189,9 @@ func (b *Board) DrawWithHighlight(screen *ebiten.Image, tick int, highlightPlace
 			case config.TileSpecial:
 				tileColor = config.ColorBuildTile
 			}
+			if b.Maze && b.Paths[config.Pos{X: x, Y: y}] {
+				tileColor = config.ColorPathTile
+			}
 
 			// Fill tile
 			vector.DrawFilledRect(screen, sx+1, sy+1, ts-2, ts-2, tileColor, false)
@@ -174,6 +237,11 @@ func (b *Board) drawPath(screen *ebiten.Image, pd data.PathDef, tick int) {
 		x2 := float32(config.BoardOffsetX+pd.Waypoints[i+1].X*config.TileSize) + float32(config.TileSize)/2
 		y2 := float32(config.BoardOffsetY+pd.Waypoints[i+1].Y*config.TileSize) + float32(config.TileSize)/2
 
+		if pd.Air {
+			b.drawAirSegment(screen, x1, y1, x2, y2, tick)
+			continue
+		}
+
 		// Glow layer
 		pulse := float64(tick%60) / 60.0
 		alpha := uint8(40 + int(20*math.Sin(pulse*math.Pi*2)))
@@ -202,7 +270,78 @@ func (b *Board) drawPath(screen *ebiten.Image, pd data.PathDef, tick int) {
 		wy2 := float64(config.BoardOffsetY+pd.Waypoints[seg+1].Y*config.TileSize) + float64(config.TileSize)/2
 		dx := wx1 + (wx2-wx1)*t
 		dy := wy1 + (wy2-wy1)*t
-		vector.DrawFilledCircle(screen, float32(dx), float32(dy), 3, color.RGBA{0, 255, 255, 180}, false)
+		dotColor := color.RGBA{0, 255, 255, 180}
+		if pd.Air {
+			dotColor = color.RGBA{200, 100, 255, 180}
+		}
+		vector.DrawFilledCircle(screen, float32(dx), float32(dy), 3, dotColor, false)
+	}
+
+	b.drawBranchArrows(screen, pd, tick)
+}
+
+// drawBranchArrows marks a fork with one arrow per branch, thicker for
+// branches with more weight
+func (b *Board) drawBranchArrows(screen *ebiten.Image, pd data.PathDef, tick int) {
+	if len(pd.Branches) < 2 {
+		return
+	}
+	fork := pd.Waypoints[len(pd.Waypoints)-1]
+	cx := float32(config.BoardOffsetX+fork.X*config.TileSize) + float32(config.TileSize)/2
+	cy := float32(config.BoardOffsetY+fork.Y*config.TileSize) + float32(config.TileSize)/2
+
+	total := 0.0
+	for _, br := range pd.Branches {
+		total += data.BranchWeight(br)
+	}
+	pulse := math.Sin(float64(tick%60)/60.0*math.Pi*2)*0.25 + 0.75
+	clr := color.RGBA{255, 220, 0, uint8(220 * pulse)}
+
+	vector.StrokeCircle(screen, cx, cy, 7, 1.5, clr, false)
+	for _, br := range pd.Branches {
+		next := b.PathByID(br.PathID)
+		if next == nil || len(next.Waypoints) < 2 {
+			continue
+		}
+		dx := float32(next.Waypoints[1].X - fork.X)
+		dy := float32(next.Waypoints[1].Y - fork.Y)
+		length := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+		if length == 0 {
+			continue
+		}
+		dx, dy = dx/length, dy/length
+
+		width := float32(1.5)
+		if pd.BranchRule == "" || pd.BranchRule == config.BranchWeighted {
+			width += float32(3 * data.BranchWeight(br) / total)
+		}
+		x1, y1 := cx+dx*9, cy+dy*9
+		x2, y2 := cx+dx*24, cy+dy*24
+		vector.StrokeLine(screen, x1, y1, x2, y2, width, clr, false)
+		// Arrowhead
+		vector.StrokeLine(screen, x2, y2, x2-dx*6-dy*5, y2-dy*6+dx*5, width, clr, false)
+		vector.StrokeLine(screen, x2, y2, x2-dx*6+dy*5, y2-dy*6-dx*5, width, clr, false)
+	}
+}
+
+// drawAirSegment draws one leg of an air lane as a dashed line
+func (b *Board) drawAirSegment(screen *ebiten.Image, x1, y1, x2, y2 float32, tick int) {
+	dx := x2 - x1
+	dy := y2 - y1
+	length := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+	if length == 0 {
+		return
+	}
+	dash := float32(8)
+	offset := float32(tick%32) / 2
+	for d := -dash*2 + offset; d < length; d += dash * 2 {
+		s := max(d, 0)
+		e := min(d+dash, length)
+		if e <= s {
+			continue
+		}
+		vector.StrokeLine(screen, x1+dx*s/length, y1+dy*s/length, x1+dx*e/length, y1+dy*e/length, 2,
+			color.RGBA{200, 100, 255, 110}, false)
 	}
 }
 
@@ -258,6 +397,33 @@ func (b *Board) drawSpecialIcon(screen *ebiten.Image, pos config.Pos, sp config.
 
 	// Small indicator dot
 	vector.DrawFilledCircle(screen, cx, cy, 4, c, false)
+
+	def := data.SpecialDefs[sp]
+	if def == nil {
+		return
+	}
+
+	// Stealth detection radius
+	if def.RevealRadius > 0 {
+		pulse := math.Sin(float64(tick%90)/90.0*math.Pi*2)*0.3 + 0.7
+		r := float32(def.RevealRadius*config.TileSize) + float32(config.TileSize)/2
+		vector.StrokeCircle(screen, cx, cy, r, 1, color.RGBA{c.R, c.G, c.B, uint8(40 * pulse)}, false)
+	}
+
+	// Area effect radius
+	if def.Radius > 0 {
+		r := float32(def.Radius*float64(config.TileSize)) + float32(config.TileSize)/2
+		vector.StrokeCircle(screen, cx, cy, r, 1, color.RGBA{c.R, c.G, c.B, 50}, false)
+	}
+}
+
+// SpecialAt returns the special tile definition at the given grid position, or nil
+func (b *Board) SpecialAt(x, y int) *data.SpecialDef {
+	sp, ok := b.Specials[config.Pos{X: x, Y: y}]
+	if !ok {
+		return nil
+	}
+	return data.SpecialDefs[sp]
 }
 
 func (b *Board) nodeList() []config.Pos {
@@ -0,0 +1,152 @@
+package board
+
+import (
+	"container/heap"
+
+	"neonsigil/internal/config"
+)
+
+// routeNode is one entry of the A* open set
+type routeNode struct {
+	pos   config.Pos
+	f, g  int
+	order int // insertion order, keeps ties deterministic
+}
+
+type routeHeap []routeNode
+
+func (h routeHeap) Len() int { return len(h) }
+func (h routeHeap) Less(i, j int) bool {
+	if h[i].f != h[j].f {
+		return h[i].f < h[j].f
+	}
+	return h[i].order < h[j].order
+}
+func (h routeHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *routeHeap) Push(x any)   { *h = append(*h, x.(routeNode)) }
+func (h *routeHeap) Pop() any {
+	old := *h
+	n := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return n
+}
+
+// routeDirs are the grid steps enemies may take (no diagonals)
+var routeDirs = []config.Pos{{X: 1, Y: 0}, {X: 0, Y: 1}, {X: -1, Y: 0}, {X: 0, Y: -1}}
+
+// Walkable reports whether ground enemies can cross a tile in maze mode.
+// Stage blocks are solid; any tile in blocked (units, for example) is too.
+func (b *Board) Walkable(p config.Pos, blocked map[config.Pos]bool) bool {
+	if p.X < 0 || p.X >= config.BoardCols || p.Y < 0 || p.Y >= config.BoardRows {
+		return false
+	}
+	return b.Tiles[p.X][p.Y] != config.TileBlock && !blocked[p]
+}
+
+// FindRoute returns the shortest walkable route from one tile to another
+// (both ends included) using A*, or nil when there is none
+func (b *Board) FindRoute(from, to config.Pos, blocked map[config.Pos]bool) []config.Pos {
+	dist := func(p config.Pos) int {
+		return abs(p.X-to.X) + abs(p.Y-to.Y)
+	}
+
+	open := &routeHeap{{pos: from, f: dist(from)}}
+	cameFrom := make(map[config.Pos]config.Pos)
+	cost := map[config.Pos]int{from: 0}
+	order := 0
+
+	for open.Len() > 0 {
+		cur := heap.Pop(open).(routeNode)
+		if cur.pos == to {
+			route := []config.Pos{to}
+			for p := to; p != from; {
+				p = cameFrom[p]
+				route = append(route, p)
+			}
+			for i, j := 0, len(route)-1; i < j; i, j = i+1, j-1 {
+				route[i], route[j] = route[j], route[i]
+			}
+			return route
+		}
+		if cur.g > cost[cur.pos] {
+			continue
+		}
+		for _, d := range routeDirs {
+			next := config.Pos{X: cur.pos.X + d.X, Y: cur.pos.Y + d.Y}
+			if next != to && !b.Walkable(next, blocked) {
+				continue
+			}
+			g := cur.g + 1
+			if old, seen := cost[next]; seen && g >= old {
+				continue
+			}
+			cost[next] = g
+			cameFrom[next] = cur.pos
+			order++
+			heap.Push(open, routeNode{pos: next, f: g + dist(next), g: g, order: order})
+		}
+	}
+	return nil
+}
+
+// Reroute recomputes every maze route around the blocked tiles. Nothing
+// changes and false is returned when any spawn is cut off from its exit.
+func (b *Board) Reroute(blocked map[config.Pos]bool) bool {
+	if !b.Maze {
+		return true
+	}
+	routes := make([][]config.Pos, len(b.PathDefs))
+	for i, pd := range b.PathDefs {
+		if pd.Air {
+			continue
+		}
+		ends := b.mazeEnds[pd.ID]
+		routes[i] = b.FindRoute(ends[0], ends[1], blocked)
+		if routes[i] == nil {
+			return false
+		}
+	}
+
+	b.Paths = make(map[config.Pos]bool)
+	for i := range b.PathDefs {
+		if routes[i] == nil {
+			continue
+		}
+		b.PathDefs[i].Waypoints = routes[i]
+		for _, wp := range routes[i] {
+			b.Paths[wp] = true
+		}
+	}
+	return true
+}
+
+// Routable reports whether every maze spawn can still reach its exit with
+// the given tiles blocked
+func (b *Board) Routable(blocked map[config.Pos]bool) bool {
+	return !b.Maze || b.PreviewRoutes(blocked) != nil
+}
+
+// PreviewRoutes returns the maze routes the given blocked tiles would
+// produce, or nil when any spawn would be cut off
+func (b *Board) PreviewRoutes(blocked map[config.Pos]bool) [][]config.Pos {
+	var routes [][]config.Pos
+	for _, pd := range b.PathDefs {
+		if pd.Air {
+			continue
+		}
+		ends := b.mazeEnds[pd.ID]
+		route := b.FindRoute(ends[0], ends[1], blocked)
+		if route == nil {
+			return nil
+		}
+		routes = append(routes, route)
+	}
+	return routes
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -20,6 +20,41 @@ const (
 	ShopSlots  = 5
 	BenchSlots = 8
 	RerollCost = 2
+
+	MaxShopLevel = 6
+	MaxStar      = 3 // TRI-FUSE stops at 3 stars
+	XPBuyCost    = 4 // default gold per XP purchase
+	XPBuyAmount  = 4 // default XP per purchase
+	XPPerWave    = 2 // default passive XP after each wave
+)
+
+// GoldReason labels a gold transaction in the ledger
+type GoldReason string
+
+const (
+	GoldKill     GoldReason = "KILL"
+	GoldWave     GoldReason = "WAVE"
+	GoldInterest GoldReason = "INTEREST"
+	GoldStreak   GoldReason = "STREAK"
+	GoldSell     GoldReason = "SELL"
+	GoldBuyUnit  GoldReason = "BUY UNIT"
+	GoldReroll   GoldReason = "REROLL"
+	GoldBuyXP    GoldReason = "BUY XP"
+	GoldBuyPart  GoldReason = "BUY PART"
+	GoldBuyTrap  GoldReason = "BUY TRAP"
+	GoldRefund   GoldReason = "TRAP REFUND" // bought trap lifted by a maze reroute
+)
+
+// Item constants
+const (
+	ItemSlots      = 3 // equipment slots per unit
+	InventorySlots = 6
+	ComponentCost  = 3
+)
+
+// Trap constants
+const (
+	MaxTraps = 4 // bought traps on the path at once (skill traps are not counted)
 )
 
 // Game states
@@ -73,6 +108,8 @@ const (
 	EnemyFlyer    EnemyType = "FLYER"
 	EnemyStalker  EnemyType = "STALKER"
 	EnemyHacker   EnemyType = "HACKER"
+	EnemyThrottle EnemyType = "THROTTLER"
+	EnemyScramble EnemyType = "SCRAMBLER"
 	EnemyCharger  EnemyType = "CHARGER"
 	EnemyTotem    EnemyType = "TOTEM"
 	EnemyBoss     EnemyType = "BOSS_GATE"
@@ -106,6 +143,154 @@ const (
 	TargetFrontmost TargetMode = "FRONTMOST"
 	TargetLowHP     TargetMode = "LOW_HP"
 	TargetNearest   TargetMode = "NEAREST"
+	TargetAirFirst  TargetMode = "AIR_FIRST"
+	TargetAuraFirst TargetMode = "AURA_FIRST"
+	TargetStrongest TargetMode = "STRONGEST" // highest current HP
+	TargetLast      TargetMode = "LAST"      // furthest from the exit
+	TargetSticky    TargetMode = "STICKY"    // first in range, kept until it leaves
+	TargetMaxHP     TargetMode = "MAX_HP"    // highest max HP
+)
+
+// TargetModes lists the player-selectable targeting priorities in cycle order
+var TargetModes = []TargetMode{
+	TargetFrontmost, TargetLast, TargetSticky, TargetNearest, TargetLowHP,
+	TargetStrongest, TargetMaxHP, TargetAirFirst, TargetAuraFirst,
+}
+
+// How enemies pick a branch at a path fork
+type BranchRule string
+
+const (
+	BranchWeighted      BranchRule = "WEIGHTED"       // random by branch weight (seeded)
+	BranchRandom        BranchRule = "RANDOM"         // uniform random (seeded)
+	BranchAlternate     BranchRule = "ALTERNATE"      // take each branch in turn
+	BranchLeastDefended BranchRule = "LEAST_DEFENDED" // branch covered by the fewest units
+)
+
+// How a wave group lets its enemies out
+type SpawnFormation string
+
+const (
+	FormSingle  SpawnFormation = "SINGLE"  // one enemy every Interval
+	FormCluster SpawnFormation = "CLUSTER" // Size enemies at once, packed nose to tail, every Interval
+	FormBurst   SpawnFormation = "BURST"   // Size enemies in quick succession, then Interval
+)
+
+// DefaultFormationSize is used when a CLUSTER or BURST group leaves Size at 0
+const DefaultFormationSize = 3
+
+// Movement layers
+type MoveMode string
+
+const (
+	MoveGround MoveMode = "GROUND"
+	MoveAir    MoveMode = "AIR"
+)
+
+// Disruption effects applied by HACKER pulses
+type DisruptType string
+
+const (
+	DisruptSilence DisruptType = "SILENCE" // unit cannot attack
+	DisruptSlow    DisruptType = "SLOW"    // attack cooldown recovers at half speed
+	DisruptJam     DisruptType = "JAM"     // targeting priority is inverted
+)
+
+// Boss phase actions
+type BossActionType string
+
+const (
+	BossShield      BossActionType = "SHIELD"       // absorb Value x MaxHP damage
+	BossSummon      BossActionType = "SUMMON"       // spawn Count of Enemy at the boss
+	BossSpeed       BossActionType = "SPEED"        // move at Value x speed for Duration
+	BossDisableNode BossActionType = "DISABLE_NODE" // switch off one node for Duration
+)
+
+// Barrier effect kinds
+type BarrierEffectType string
+
+const (
+	BarrierSlow   BarrierEffectType = "SLOW"   // slow for Duration
+	BarrierMark   BarrierEffectType = "MARK"   // take Value extra damage for Duration
+	BarrierDamage BarrierEffectType = "DAMAGE" // lose Value x MaxHP immediately
+	BarrierReveal BarrierEffectType = "REVEAL" // stay visible for Duration
+	BarrierStun   BarrierEffectType = "STUN"   // stun for Duration
+	BarrierZone   BarrierEffectType = "ZONE"   // leave the Zone ground effect behind
+)
+
+// Ground zone effects
+type ZoneType string
+
+const (
+	ZoneDamage     ZoneType = "DOT"        // damage every tick
+	ZoneSlow       ZoneType = "SLOW"       // reduce movement speed by Value
+	ZoneVulnerable ZoneType = "VULNERABLE" // take Value extra damage
+)
+
+// How a new zone combines with an existing zone of the same type on the same tile
+type ZoneStacking string
+
+const (
+	StackRefresh     ZoneStacking = "REFRESH"     // reset the duration
+	StackIntensify   ZoneStacking = "INTENSIFY"   // add a stack (up to MaxStacks) and reset the duration
+	StackIndependent ZoneStacking = "INDEPENDENT" // separate zones, effects overlap
+)
+
+// Temporary entities created by unit skills
+type SummonKind string
+
+const (
+	SummonDrone  SummonKind = "DRONE"  // orbits its owner and shoots nearby enemies
+	SummonBlock  SummonKind = "BLOCK"  // stands on a path tile and holds ground enemies until broken
+	SummonTurret SummonKind = "TURRET" // occupies a free build tile next to its owner and shoots
+)
+
+// Path traps
+type TrapType string
+
+const (
+	TrapSpike TrapType = "SPIKE" // damage every ground enemy on the tile
+	TrapSnare TrapType = "SNARE" // stun the enemy that steps on it
+	TrapMine  TrapType = "MINE"  // explode, damaging everything within Radius
+)
+
+// Barrier effect targets
+type BarrierTarget string
+
+const (
+	BarrierTargetAll     BarrierTarget = "ALL"
+	BarrierTargetGround  BarrierTarget = "GROUND"
+	BarrierTargetAir     BarrierTarget = "AIR"
+	BarrierTargetStealth BarrierTarget = "STEALTH"
+)
+
+// On-hit status effects
+type StatusType string
+
+const (
+	StatusSlow StatusType = "SLOW"
+	StatusStun StatusType = "STUN"
+	StatusMark StatusType = "MARK"
+)
+
+// Projectile archetypes for ranged attacks
+type ProjectileKind string
+
+const (
+	ProjSingle ProjectileKind = "SINGLE" // homing shot, one target
+	ProjSplash ProjectileKind = "SPLASH" // explodes on impact, damage falls off with distance
+	ProjPierce ProjectileKind = "PIERCE" // straight line through every enemy on the way
+	ProjChain  ProjectileKind = "CHAIN"  // jumps between nearby enemies after the first hit
+	ProjBeam   ProjectileKind = "BEAM"   // instant hit, no travel time
+)
+
+// What a projectile does when its target dies or leaks mid-flight
+type TargetLossBehavior string
+
+const (
+	LossFizzle   TargetLossBehavior = "FIZZLE"   // vanish, damage wasted
+	LossRetarget TargetLossBehavior = "RETARGET" // home in on the nearest enemy within RetargetRadius
+	LossImpact   TargetLossBehavior = "IMPACT"   // fly on to the last known position and impact there
 )
 
 // Attack types
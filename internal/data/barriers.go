@@ -0,0 +1,33 @@
+package data
+
+import "neonsigil/internal/config"
+
+// BarrierDefs contains all node barrier definitions, keyed by StageDef.BarrierEffect
+var BarrierDefs = map[string]*BarrierDef{
+	"BARRIER_SLOW": {
+		ID: "BARRIER_SLOW", Name: "STASIS FIELD", Duration: 3, Cooldown: 20, ChargeTime: 4,
+		Effects: []BarrierEffect{
+			{Kind: config.BarrierSlow, Target: config.BarrierTargetAll, Duration: 3},
+			{Kind: config.BarrierMark, Target: config.BarrierTargetAll, Value: 0.2, Duration: 4, Faction: config.FactionCoven},
+			{Kind: config.BarrierStun, Target: config.BarrierTargetGround, Duration: 0.5, Faction: config.FactionArcTech},
+		},
+	},
+	"BARRIER_REVEAL": {
+		ID: "BARRIER_REVEAL", Name: "LIGHT LATTICE", Duration: 3, Cooldown: 20, ChargeTime: 4,
+		Effects: []BarrierEffect{
+			{Kind: config.BarrierReveal, Target: config.BarrierTargetStealth, Duration: 6},
+			{Kind: config.BarrierSlow, Target: config.BarrierTargetStealth, Duration: 3},
+			{Kind: config.BarrierReveal, Target: config.BarrierTargetStealth, Duration: 10, Faction: config.FactionExorcist},
+		},
+	},
+	"BARRIER_MARK": {
+		ID: "BARRIER_MARK", Name: "SIGIL BRAND", Duration: 3, Cooldown: 20, ChargeTime: 5, Radius: 2,
+		Effects: []BarrierEffect{
+			{Kind: config.BarrierDamage, Target: config.BarrierTargetAll, Value: 0.05},
+			{Kind: config.BarrierMark, Target: config.BarrierTargetAll, Value: 0.25, Duration: 5},
+			{Kind: config.BarrierReveal, Target: config.BarrierTargetStealth, Duration: 5, Faction: config.FactionExorcist},
+			{Kind: config.BarrierSlow, Target: config.BarrierTargetAll, Duration: 2, Faction: config.FactionStreet},
+			{Kind: config.BarrierZone, Target: config.BarrierTargetAll, Zone: "HEX_FIELD", Faction: config.FactionCoven},
+		},
+	},
+}
@@ -0,0 +1,19 @@
+package data
+
+// DefaultEconomy is used by stages without their own economy rules
+var DefaultEconomy = &EconomyDef{
+	KillGold:        1,
+	WaveBase:        3,
+	WaveScale:       1,
+	InterestStep:    10,
+	InterestCap:     5,
+	WinStreakBonus:  []int{0, 0, 1, 1, 2, 3},
+	LossStreakBonus: []int{0, 0, 1, 2, 2, 3},
+}
+
+// TutorialEconomy keeps the opening stages to flat income
+var TutorialEconomy = &EconomyDef{
+	KillGold:  1,
+	WaveBase:  3,
+	WaveScale: 1,
+}
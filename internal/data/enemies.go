@@ -26,26 +26,59 @@ var EnemyDefs = map[config.EnemyType]*EnemyDef{
 	},
 	config.EnemyFlyer: {
 		Type: config.EnemyFlyer, Name: "FLYER", BaseHP: 100, Speed: 1.2,
-		LeakDamage: 1, Color: color.RGBA{200, 100, 255, 255},
+		LeakDamage: 1, Color: color.RGBA{200, 100, 255, 255}, Movement: config.MoveAir,
 	},
 	config.EnemyStalker: {
 		Type: config.EnemyStalker, Name: "STALKER", BaseHP: 110, Speed: 1.1,
-		LeakDamage: 1, Color: color.RGBA{80, 80, 80, 255},
+		LeakDamage: 1, Color: color.RGBA{80, 80, 80, 255}, Stealth: true,
 	},
 	config.EnemyHacker: {
 		Type: config.EnemyHacker, Name: "HACKER", BaseHP: 160, Speed: 0.95,
 		LeakDamage: 2, Color: color.RGBA{0, 255, 200, 255},
+		Disrupt: config.DisruptSilence, DisruptRadius: 1.5, DisruptCooldown: 5.0, DisruptDuration: 2.0,
+	},
+	config.EnemyThrottle: {
+		Type: config.EnemyThrottle, Name: "THROTTLER", BaseHP: 160, Speed: 0.95,
+		LeakDamage: 2, Color: color.RGBA{0, 180, 255, 255},
+		Disrupt: config.DisruptSlow, DisruptRadius: 2.0, DisruptCooldown: 6.0, DisruptDuration: 3.0,
+	},
+	config.EnemyScramble: {
+		Type: config.EnemyScramble, Name: "SCRAMBLER", BaseHP: 160, Speed: 0.95,
+		LeakDamage: 2, Color: color.RGBA{120, 255, 120, 255},
+		Disrupt: config.DisruptJam, DisruptRadius: 1.5, DisruptCooldown: 4.5, DisruptDuration: 3.0,
 	},
 	config.EnemyCharger: {
 		Type: config.EnemyCharger, Name: "CHARGER", BaseHP: 240, Speed: 1.15,
 		LeakDamage: 3, Color: color.RGBA{255, 80, 80, 255},
+		DashCooldown: 3.5, DashWindup: 0.8, DashDistance: 3, DashSpeedMul: 5.0,
 	},
 	config.EnemyTotem: {
 		Type: config.EnemyTotem, Name: "TOTEM", BaseHP: 300, Speed: 0.7,
 		LeakDamage: 3, Color: color.RGBA{255, 255, 100, 255},
+		Aura: &AuraDef{Radius: 1.5, DmgReduce: 0.25, SpeedMul: 0.15, RegenPct: 0.02},
 	},
 	config.EnemyBoss: {
 		Type: config.EnemyBoss, Name: "GATEKEEPER", BaseHP: 2000, Speed: 0.5,
 		LeakDamage: 99, Color: color.RGBA{255, 50, 50, 255},
+		Boss: &BossDef{Phases: []BossPhaseDef{
+			{Name: "AEGIS", HPPct: 0.75, Actions: []BossAction{
+				{Kind: config.BossShield, Value: 0.15},
+			}},
+			{Name: "SWARM", HPPct: 0.50, Actions: []BossAction{
+				{Kind: config.BossSummon, Enemy: config.EnemyRunner, Count: 6},
+			}},
+			{Name: "OVERDRIVE", HPPct: 0.30, Actions: []BossAction{
+				{Kind: config.BossSpeed, Value: 2.0, Duration: 4},
+			}},
+			{Name: "LOCKDOWN", HPPct: 0.15, Actions: []BossAction{
+				{Kind: config.BossDisableNode, Duration: 10},
+				{Kind: config.BossSummon, Enemy: config.EnemyRunner, Count: 4},
+			}},
+		}},
 	},
 }
+
+// DisruptResist reduces disruption duration on units of the given faction (0.5 = half duration)
+var DisruptResist = map[config.Faction]float64{
+	config.FactionArcTech: 0.5,
+}
@@ -0,0 +1,86 @@
+package data
+
+import (
+	"image/color"
+
+	"neonsigil/internal/config"
+)
+
+// ItemDefs contains all components and finished items
+var ItemDefs = []*ItemDef{
+	// Components
+	{ID: "BLADE", Name: "BLADE", Component: true, Color: color.RGBA{255, 90, 90, 255}, ATKPct: 0.10},
+	{ID: "COIL", Name: "COIL", Component: true, Color: color.RGBA{0, 200, 255, 255}, AtkSpeedPct: 0.10},
+	{ID: "LENS", Name: "LENS", Component: true, Color: color.RGBA{0, 255, 136, 255}, AirMulBonus: 0.15},
+	{ID: "CHARM", Name: "CHARM", Component: true, Color: color.RGBA{200, 100, 255, 255}, DisruptRes: 0.25},
+
+	// Finished items
+	{ID: "EDGE", Name: "NEON EDGE", Recipe: [2]string{"BLADE", "BLADE"}, Color: color.RGBA{255, 60, 60, 255},
+		ATKPct: 0.35},
+	{ID: "RAZOR", Name: "RAZOR CIRCUIT", Recipe: [2]string{"BLADE", "COIL"}, Color: color.RGBA{255, 120, 200, 255},
+		ATKPct: 0.20, AtkSpeedPct: 0.20},
+	{ID: "OVERCLOCK", Name: "OVERCLOCK", Recipe: [2]string{"COIL", "COIL"}, Color: color.RGBA{0, 160, 255, 255},
+		AtkSpeedPct: 0.40},
+	{ID: "SCOPE", Name: "LONG SCOPE", Recipe: [2]string{"LENS", "LENS"}, Color: color.RGBA{0, 255, 180, 255},
+		RangeBonus: 1, AirMulBonus: 0.25},
+	{ID: "BRAND", Name: "MARKER ROUND", Recipe: [2]string{"BLADE", "LENS"}, Color: color.RGBA{255, 0, 255, 255},
+		ATKPct: 0.10, OnHit: &OnHitEffect{Kind: config.StatusMark, Value: 0.15, Duration: 2}},
+	{ID: "CAPACITOR", Name: "STUN CAPACITOR", Recipe: [2]string{"COIL", "LENS"}, Color: color.RGBA{255, 255, 80, 255},
+		AtkSpeedPct: 0.10, OnHit: &OnHitEffect{Kind: config.StatusStun, Duration: 0.5, Every: 4}},
+	{ID: "HEX", Name: "HEX BLADE", Recipe: [2]string{"BLADE", "CHARM"}, Color: color.RGBA{180, 60, 255, 255},
+		ATKPct: 0.10, OnHit: &OnHitEffect{Kind: config.StatusSlow, Duration: 1.5}},
+	{ID: "WARD", Name: "FARADAY WARD", Recipe: [2]string{"CHARM", "CHARM"}, Color: color.RGBA{220, 220, 255, 255},
+		DisruptRes: 1.0},
+	{ID: "FOCUS", Name: "FOCUS CHARM", Recipe: [2]string{"COIL", "CHARM"}, Color: color.RGBA{120, 120, 255, 255},
+		AtkSpeedPct: 0.15, DisruptRes: 0.5},
+	{ID: "SIGHT", Name: "TRUE SIGHT", Recipe: [2]string{"LENS", "CHARM"}, Color: color.RGBA{255, 230, 150, 255},
+		RangeBonus: 1, DisruptRes: 0.25},
+
+	// Consumables (placed on path tiles)
+	{ID: "TAR", Name: "TAR BOMB", Consumable: true, Color: color.RGBA{120, 90, 40, 255}, Zone: "TAR_FIELD"},
+	{ID: "NAPALM", Name: "NAPALM", Consumable: true, Color: color.RGBA{255, 110, 0, 255}, Zone: "NAPALM"},
+}
+
+// ItemDefByID provides quick lookup
+var ItemDefByID = func() map[string]*ItemDef {
+	m := make(map[string]*ItemDef)
+	for _, it := range ItemDefs {
+		m[it.ID] = it
+	}
+	return m
+}()
+
+// GetComponents returns all item components
+func GetComponents() []*ItemDef {
+	var result []*ItemDef
+	for _, it := range ItemDefs {
+		if it.Component {
+			result = append(result, it)
+		}
+	}
+	return result
+}
+
+// GetDrops returns everything that can drop at the end of a wave
+func GetDrops() []*ItemDef {
+	var result []*ItemDef
+	for _, it := range ItemDefs {
+		if it.Component || it.Consumable {
+			result = append(result, it)
+		}
+	}
+	return result
+}
+
+// FindRecipe returns the finished item crafted from the two components, or nil
+func FindRecipe(a, b string) *ItemDef {
+	for _, it := range ItemDefs {
+		if it.Component || it.Consumable {
+			continue
+		}
+		if (it.Recipe[0] == a && it.Recipe[1] == b) || (it.Recipe[0] == b && it.Recipe[1] == a) {
+			return it
+		}
+	}
+	return nil
+}
@@ -0,0 +1,9 @@
+package data
+
+// BranchWeight returns a fork branch's weight, defaulting to 1
+func BranchWeight(br PathBranch) float64 {
+	if br.Weight <= 0 {
+		return 1
+	}
+	return br.Weight
+}
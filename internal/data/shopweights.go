@@ -10,6 +10,17 @@ var ShopWeights = map[int][]float64{
 	6: {0.20, 0.25, 0.30, 0.25},
 }
 
+// XPCurve is the default XP needed to advance from each shop level (index = level)
+var XPCurve = []int{0, 2, 4, 8, 14, 20}
+
+// PoolCopies is the number of copies of each unit in the shared pool, by cost
+var PoolCopies = map[int]int{
+	1: 12,
+	2: 9,
+	3: 6,
+	4: 4,
+}
+
 // GetUnitsForCost returns all unit definitions with the given cost
 func GetUnitsForCost(cost int) []*UnitDef {
 	var result []*UnitDef
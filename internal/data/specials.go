@@ -0,0 +1,26 @@
+package data
+
+import "neonsigil/internal/config"
+
+// SpecialDefs contains the gameplay effect of each special tile type
+var SpecialDefs = map[config.SpecialType]*SpecialDef{
+	config.SpecialSeal: {
+		Type: config.SpecialSeal, Name: "SEAL",
+		Desc:      "Place a unit to arm. Enemies crossing are halted once, then slowed.",
+		NeedsUnit: true, Radius: 1.0, HaltTime: 0.8, Slows: true,
+	},
+	config.SpecialAntenna: {
+		Type: config.SpecialAntenna, Name: "ANTENNA",
+		Desc:         "Reveals stealth nearby. A unit on it gains +1 range.",
+		RevealRadius: 2, RangeBonus: 1,
+	},
+	config.SpecialWorkbench: {
+		Type: config.SpecialWorkbench, Name: "WORKBENCH",
+		Desc: "Man it with a unit to craft items from components.",
+	},
+	config.SpecialGround: {
+		Type: config.SpecialGround, Name: "GROUND",
+		Desc:          "Grounded: the unit on it is immune to hacker disruption.",
+		DisruptResist: 1.0,
+	},
+}
@@ -14,14 +14,14 @@ var Stages = []*StageDef{
 			{X: 0, Y: 3}, {X: 1, Y: 3}, {X: 2, Y: 3}, {X: 2, Y: 2}, {X: 2, Y: 1}, {X: 3, Y: 1}, {X: 4, Y: 1}, {X: 5, Y: 1}, {X: 5, Y: 2}, {X: 5, Y: 3}, {X: 5, Y: 4}, {X: 5, Y: 5}, {X: 6, Y: 5}, {X: 7, Y: 5},
 		}}},
 		Waves: []WaveDef{
-			{ID: "W1", Groups: []WaveGroup{{config.EnemyRunner, 14, 0.60, "P0"}}},
-			{ID: "W2", Groups: []WaveGroup{{config.EnemyRunner, 10, 0.55, "P0"}, {config.EnemyBruiser, 2, 1.20, "P0"}}},
-			{ID: "W3", Groups: []WaveGroup{{config.EnemyRunner, 16, 0.55, "P0"}}},
-			{ID: "W4", Groups: []WaveGroup{{config.EnemyBruiser, 4, 1.10, "P0"}}},
-			{ID: "W5", Groups: []WaveGroup{{config.EnemyRunner, 10, 0.55, "P0"}, {config.EnemyBruiser, 3, 1.10, "P0"}}},
-			{ID: "W6", Groups: []WaveGroup{{config.EnemyShield, 3, 1.00, "P0"}, {config.EnemyRunner, 10, 0.55, "P0"}}},
+			{ID: "W1", Groups: []WaveGroup{{Enemy: config.EnemyRunner, Count: 14, Interval: 0.60, PathID: "P0"}}},
+			{ID: "W2", Groups: []WaveGroup{{Enemy: config.EnemyRunner, Count: 10, Interval: 0.55, PathID: "P0"}, {Enemy: config.EnemyBruiser, Count: 2, Interval: 1.20, PathID: "P0"}}},
+			{ID: "W3", Groups: []WaveGroup{{Enemy: config.EnemyRunner, Count: 16, Interval: 0.55, PathID: "P0"}}},
+			{ID: "W4", Groups: []WaveGroup{{Enemy: config.EnemyBruiser, Count: 4, Interval: 1.10, PathID: "P0"}}},
+			{ID: "W5", Groups: []WaveGroup{{Enemy: config.EnemyRunner, Count: 10, Interval: 0.55, PathID: "P0"}, {Enemy: config.EnemyBruiser, Count: 3, Interval: 1.10, PathID: "P0"}}},
+			{ID: "W6", Groups: []WaveGroup{{Enemy: config.EnemyShield, Count: 3, Interval: 1.00, PathID: "P0"}, {Enemy: config.EnemyRunner, Count: 10, Interval: 0.55, PathID: "P0"}}},
 		},
-		EnemyHPMul: 0.95, EnemySpdMul: 1.0,
+		EnemyHPMul: 0.95, EnemySpdMul: 1.0, Economy: TutorialEconomy,
 	},
 	{
 		ID: "CH1-02", Name: "Cheap Tricks",
@@ -33,14 +33,14 @@ var Stages = []*StageDef{
 			{X: 0, Y: 4}, {X: 1, Y: 4}, {X: 2, Y: 4}, {X: 2, Y: 3}, {X: 2, Y: 2}, {X: 3, Y: 2}, {X: 4, Y: 2}, {X: 5, Y: 2}, {X: 5, Y: 3}, {X: 5, Y: 4}, {X: 6, Y: 4}, {X: 7, Y: 4},
 		}}},
 		Waves: []WaveDef{
-			{ID: "W1", Groups: []WaveGroup{{config.EnemyRunner, 16, 0.55, "P0"}}},
-			{ID: "W2", Groups: []WaveGroup{{config.EnemyRunner, 10, 0.55, "P0"}, {config.EnemyBruiser, 3, 1.10, "P0"}}},
-			{ID: "W3", Groups: []WaveGroup{{config.EnemyBruiser, 5, 1.10, "P0"}}},
-			{ID: "W4", Groups: []WaveGroup{{config.EnemyRunner, 18, 0.50, "P0"}}},
-			{ID: "W5", Groups: []WaveGroup{{config.EnemyShield, 4, 1.00, "P0"}, {config.EnemyRunner, 10, 0.55, "P0"}}},
-			{ID: "W6", Groups: []WaveGroup{{config.EnemyBruiser, 4, 1.10, "P0"}, {config.EnemyShield, 4, 1.00, "P0"}}},
+			{ID: "W1", Groups: []WaveGroup{{Enemy: config.EnemyRunner, Count: 16, Interval: 0.55, PathID: "P0"}}},
+			{ID: "W2", Groups: []WaveGroup{{Enemy: config.EnemyRunner, Count: 10, Interval: 0.55, PathID: "P0"}, {Enemy: config.EnemyBruiser, Count: 3, Interval: 1.10, PathID: "P0"}}},
+			{ID: "W3", Groups: []WaveGroup{{Enemy: config.EnemyBruiser, Count: 5, Interval: 1.10, PathID: "P0"}}},
+			{ID: "W4", Groups: []WaveGroup{{Enemy: config.EnemyRunner, Count: 18, Interval: 0.50, PathID: "P0"}}},
+			{ID: "W5", Groups: []WaveGroup{{Enemy: config.EnemyShield, Count: 4, Interval: 1.00, PathID: "P0"}, {Enemy: config.EnemyRunner, Count: 10, Interval: 0.55, PathID: "P0"}}},
+			{ID: "W6", Groups: []WaveGroup{{Enemy: config.EnemyBruiser, Count: 4, Interval: 1.10, PathID: "P0"}, {Enemy: config.EnemyShield, Count: 4, Interval: 1.00, PathID: "P0"}}},
 		},
-		EnemyHPMul: 1.0, EnemySpdMul: 1.0,
+		EnemyHPMul: 1.0, EnemySpdMul: 1.0, Economy: TutorialEconomy,
 	},
 	{
 		ID: "CH1-03", Name: "Breakpoint",
@@ -52,12 +52,12 @@ var Stages = []*StageDef{
 			{X: 0, Y: 2}, {X: 1, Y: 2}, {X: 2, Y: 2}, {X: 3, Y: 2}, {X: 3, Y: 3}, {X: 3, Y: 4}, {X: 3, Y: 5}, {X: 4, Y: 5}, {X: 5, Y: 5}, {X: 6, Y: 5}, {X: 7, Y: 5},
 		}}},
 		Waves: []WaveDef{
-			{ID: "W1", Groups: []WaveGroup{{config.EnemyRunner, 18, 0.55, "P0"}}},
-			{ID: "W2", Groups: []WaveGroup{{config.EnemyBruiser, 4, 1.10, "P0"}, {config.EnemyRunner, 8, 0.55, "P0"}}},
-			{ID: "W3", Groups: []WaveGroup{{config.EnemyShield, 6, 1.00, "P0"}}},
-			{ID: "W4", Groups: []WaveGroup{{config.EnemyRunner, 22, 0.50, "P0"}}},
-			{ID: "W5", Groups: []WaveGroup{{config.EnemyBruiser, 5, 1.10, "P0"}, {config.EnemyShield, 4, 1.00, "P0"}}},
-			{ID: "W6", Groups: []WaveGroup{{config.EnemyShield, 6, 1.00, "P0"}, {config.EnemyRunner, 12, 0.55, "P0"}}},
+			{ID: "W1", Groups: []WaveGroup{{Enemy: config.EnemyRunner, Count: 18, Interval: 0.55, PathID: "P0"}}},
+			{ID: "W2", Groups: []WaveGroup{{Enemy: config.EnemyBruiser, Count: 4, Interval: 1.10, PathID: "P0"}, {Enemy: config.EnemyRunner, Count: 8, Interval: 0.55, PathID: "P0"}}},
+			{ID: "W3", Groups: []WaveGroup{{Enemy: config.EnemyShield, Count: 6, Interval: 1.00, PathID: "P0"}}},
+			{ID: "W4", Groups: []WaveGroup{{Enemy: config.EnemyRunner, Count: 22, Interval: 0.50, PathID: "P0"}}},
+			{ID: "W5", Groups: []WaveGroup{{Enemy: config.EnemyBruiser, Count: 5, Interval: 1.10, PathID: "P0"}, {Enemy: config.EnemyShield, Count: 4, Interval: 1.00, PathID: "P0"}}},
+			{ID: "W6", Groups: []WaveGroup{{Enemy: config.EnemyShield, Count: 6, Interval: 1.00, PathID: "P0"}, {Enemy: config.EnemyRunner, Count: 12, Interval: 0.55, PathID: "P0"}}},
 		},
 		EnemyHPMul: 1.05, EnemySpdMul: 1.0,
 	},
@@ -71,12 +71,12 @@ var Stages = []*StageDef{
 			{X: 0, Y: 3}, {X: 1, Y: 3}, {X: 2, Y: 3}, {X: 3, Y: 3}, {X: 4, Y: 3}, {X: 5, Y: 3}, {X: 5, Y: 2}, {X: 5, Y: 1}, {X: 6, Y: 1}, {X: 7, Y: 1},
 		}}},
 		Waves: []WaveDef{
-			{ID: "W1", Groups: []WaveGroup{{config.EnemyRunner, 16, 0.55, "P0"}}},
-			{ID: "W2", Groups: []WaveGroup{{config.EnemySplitter, 6, 0.90, "P0"}}},
-			{ID: "W3", Groups: []WaveGroup{{config.EnemyRunner, 12, 0.55, "P0"}, {config.EnemyShield, 4, 1.00, "P0"}}},
-			{ID: "W4", Groups: []WaveGroup{{config.EnemySplitter, 8, 0.90, "P0"}, {config.EnemyRunner, 6, 0.55, "P0"}}},
-			{ID: "W5", Groups: []WaveGroup{{config.EnemyBruiser, 4, 1.10, "P0"}, {config.EnemySplitter, 6, 0.90, "P0"}}},
-			{ID: "W6", Groups: []WaveGroup{{config.EnemyShield, 6, 1.00, "P0"}, {config.EnemyRunner, 10, 0.55, "P0"}}},
+			{ID: "W1", Groups: []WaveGroup{{Enemy: config.EnemyRunner, Count: 16, Interval: 0.55, PathID: "P0"}}},
+			{ID: "W2", Groups: []WaveGroup{{Enemy: config.EnemySplitter, Count: 6, Interval: 0.90, PathID: "P0"}}},
+			{ID: "W3", Groups: []WaveGroup{{Enemy: config.EnemyRunner, Count: 12, Interval: 0.55, PathID: "P0"}, {Enemy: config.EnemyShield, Count: 4, Interval: 1.00, PathID: "P0"}}},
+			{ID: "W4", Groups: []WaveGroup{{Enemy: config.EnemySplitter, Count: 8, Interval: 0.90, PathID: "P0"}, {Enemy: config.EnemyRunner, Count: 6, Interval: 0.55, PathID: "P0"}}},
+			{ID: "W5", Groups: []WaveGroup{{Enemy: config.EnemyBruiser, Count: 4, Interval: 1.10, PathID: "P0"}, {Enemy: config.EnemySplitter, Count: 6, Interval: 0.90, PathID: "P0"}}},
+			{ID: "W6", Groups: []WaveGroup{{Enemy: config.EnemyShield, Count: 6, Interval: 1.00, PathID: "P0"}, {Enemy: config.EnemyRunner, Count: 10, Interval: 0.55, PathID: "P0"}}},
 		},
 		EnemyHPMul: 1.05, EnemySpdMul: 1.0,
 	},
@@ -91,12 +91,12 @@ var Stages = []*StageDef{
 			{X: 0, Y: 5}, {X: 1, Y: 5}, {X: 2, Y: 5}, {X: 2, Y: 4}, {X: 2, Y: 3}, {X: 2, Y: 2}, {X: 2, Y: 1}, {X: 3, Y: 1}, {X: 4, Y: 1}, {X: 5, Y: 1}, {X: 6, Y: 1}, {X: 7, Y: 1},
 		}}},
 		Waves: []WaveDef{
-			{ID: "W1", Groups: []WaveGroup{{config.EnemyRunner, 18, 0.55, "P0"}}},
-			{ID: "W2", Groups: []WaveGroup{{config.EnemyBruiser, 4, 1.10, "P0"}}},
-			{ID: "W3", Groups: []WaveGroup{{config.EnemySplitter, 6, 0.90, "P0"}, {config.EnemyRunner, 8, 0.55, "P0"}}},
-			{ID: "W4", Groups: []WaveGroup{{config.EnemyShield, 6, 1.00, "P0"}}},
-			{ID: "W5", Groups: []WaveGroup{{config.EnemyBruiser, 3, 1.10, "P0"}, {config.EnemyShield, 4, 1.00, "P0"}, {config.EnemyRunner, 8, 0.55, "P0"}}},
-			{ID: "W6", Groups: []WaveGroup{{config.EnemySplitter, 10, 0.80, "P0"}}},
+			{ID: "W1", Groups: []WaveGroup{{Enemy: config.EnemyRunner, Count: 18, Interval: 0.55, PathID: "P0"}}},
+			{ID: "W2", Groups: []WaveGroup{{Enemy: config.EnemyBruiser, Count: 4, Interval: 1.10, PathID: "P0"}}},
+			{ID: "W3", Groups: []WaveGroup{{Enemy: config.EnemySplitter, Count: 6, Interval: 0.90, PathID: "P0"}, {Enemy: config.EnemyRunner, Count: 8, Interval: 0.55, PathID: "P0"}}},
+			{ID: "W4", Groups: []WaveGroup{{Enemy: config.EnemyShield, Count: 6, Interval: 1.00, PathID: "P0"}}},
+			{ID: "W5", Groups: []WaveGroup{{Enemy: config.EnemyBruiser, Count: 3, Interval: 1.10, PathID: "P0"}, {Enemy: config.EnemyShield, Count: 4, Interval: 1.00, PathID: "P0"}, {Enemy: config.EnemyRunner, Count: 8, Interval: 0.55, PathID: "P0"}}},
+			{ID: "W6", Groups: []WaveGroup{{Enemy: config.EnemySplitter, Count: 10, Interval: 0.80, PathID: "P0"}}},
 		},
 		EnemyHPMul: 1.10, EnemySpdMul: 1.0, BarrierEffect: "BARRIER_SLOW",
 	},
@@ -112,12 +112,12 @@ var Stages = []*StageDef{
 			{X: 0, Y: 2}, {X: 1, Y: 2}, {X: 2, Y: 2}, {X: 3, Y: 2}, {X: 4, Y: 2}, {X: 5, Y: 2}, {X: 5, Y: 3}, {X: 5, Y: 4}, {X: 5, Y: 5}, {X: 6, Y: 5}, {X: 7, Y: 5},
 		}}},
 		Waves: []WaveDef{
-			{ID: "W1", Groups: []WaveGroup{{config.EnemyRunner, 18, 0.55, "P0"}}},
-			{ID: "W2", Groups: []WaveGroup{{config.EnemyShield, 5, 1.00, "P0"}}},
-			{ID: "W3", Groups: []WaveGroup{{config.EnemySplitter, 6, 0.90, "P0"}, {config.EnemyRunner, 10, 0.55, "P0"}}},
-			{ID: "W4", Groups: []WaveGroup{{config.EnemyBruiser, 5, 1.10, "P0"}}},
-			{ID: "W5", Groups: []WaveGroup{{config.EnemyShield, 6, 1.00, "P0"}, {config.EnemyBruiser, 3, 1.10, "P0"}}},
-			{ID: "W6", Groups: []WaveGroup{{config.EnemySplitter, 8, 0.90, "P0"}, {config.EnemyShield, 4, 1.00, "P0"}, {config.EnemyRunner, 8, 0.55, "P0"}}},
+			{ID: "W1", Groups: []WaveGroup{{Enemy: config.EnemyRunner, Count: 18, Interval: 0.55, PathID: "P0"}}},
+			{ID: "W2", Groups: []WaveGroup{{Enemy: config.EnemyShield, Count: 5, Interval: 1.00, PathID: "P0"}}},
+			{ID: "W3", Groups: []WaveGroup{{Enemy: config.EnemySplitter, Count: 6, Interval: 0.90, PathID: "P0"}, {Enemy: config.EnemyRunner, Count: 10, Interval: 0.55, PathID: "P0"}}},
+			{ID: "W4", Groups: []WaveGroup{{Enemy: config.EnemyBruiser, Count: 5, Interval: 1.10, PathID: "P0"}}},
+			{ID: "W5", Groups: []WaveGroup{{Enemy: config.EnemyShield, Count: 6, Interval: 1.00, PathID: "P0"}, {Enemy: config.EnemyBruiser, Count: 3, Interval: 1.10, PathID: "P0"}}},
+			{ID: "W6", Groups: []WaveGroup{{Enemy: config.EnemySplitter, Count: 8, Interval: 0.90, PathID: "P0"}, {Enemy: config.EnemyShield, Count: 4, Interval: 1.00, PathID: "P0"}, {Enemy: config.EnemyRunner, Count: 8, Interval: 0.55, PathID: "P0"}}},
 		},
 		EnemyHPMul: 1.10, EnemySpdMul: 1.0, BarrierEffect: "BARRIER_SLOW",
 	},
@@ -131,15 +131,15 @@ var Stages = []*StageDef{
 		Blocks:   []config.Pos{{X: 3, Y: 4}, {X: 4, Y: 4}},
 		Paths: []PathDef{
 			{ID: "P0", Waypoints: []config.Pos{{X: 0, Y: 3}, {X: 1, Y: 3}, {X: 2, Y: 3}, {X: 3, Y: 3}, {X: 3, Y: 2}, {X: 3, Y: 1}, {X: 4, Y: 1}, {X: 5, Y: 1}, {X: 6, Y: 1}, {X: 7, Y: 1}}},
-			{ID: "P1", Waypoints: []config.Pos{{X: 0, Y: 6}, {X: 2, Y: 6}, {X: 4, Y: 6}, {X: 6, Y: 6}, {X: 7, Y: 5}}},
+			{ID: "P1", Air: true, Waypoints: []config.Pos{{X: 0, Y: 6}, {X: 3, Y: 7}, {X: 6, Y: 5}, {X: 7, Y: 5}}},
 		},
 		Waves: []WaveDef{
-			{ID: "W1", Groups: []WaveGroup{{config.EnemyRunner, 16, 0.55, "P0"}}},
-			{ID: "W2", Groups: []WaveGroup{{config.EnemyFlyer, 8, 0.80, "P1"}}},
-			{ID: "W3", Groups: []WaveGroup{{config.EnemyShield, 4, 1.00, "P0"}, {config.EnemyRunner, 10, 0.55, "P0"}}},
-			{ID: "W4", Groups: []WaveGroup{{config.EnemyFlyer, 10, 0.70, "P1"}, {config.EnemyRunner, 8, 0.55, "P0"}}},
-			{ID: "W5", Groups: []WaveGroup{{config.EnemyBruiser, 4, 1.10, "P0"}, {config.EnemyFlyer, 8, 0.80, "P1"}}},
-			{ID: "W6", Groups: []WaveGroup{{config.EnemyFlyer, 12, 0.65, "P1"}, {config.EnemyShield, 4, 1.00, "P0"}}},
+			{ID: "W1", Groups: []WaveGroup{{Enemy: config.EnemyRunner, Count: 16, Interval: 0.55, PathID: "P0"}}},
+			{ID: "W2", Groups: []WaveGroup{{Enemy: config.EnemyFlyer, Count: 8, Interval: 0.80, PathID: "P1"}}},
+			{ID: "W3", Groups: []WaveGroup{{Enemy: config.EnemyShield, Count: 4, Interval: 1.00, PathID: "P0"}, {Enemy: config.EnemyRunner, Count: 10, Interval: 0.55, PathID: "P0"}}},
+			{ID: "W4", Groups: []WaveGroup{{Enemy: config.EnemyFlyer, Count: 10, Interval: 0.70, PathID: "P1"}, {Enemy: config.EnemyRunner, Count: 8, Interval: 0.55, PathID: "P0"}}},
+			{ID: "W5", Groups: []WaveGroup{{Enemy: config.EnemyBruiser, Count: 4, Interval: 1.10, PathID: "P0"}, {Enemy: config.EnemyFlyer, Count: 8, Interval: 0.80, PathID: "P1"}}},
+			{ID: "W6", Groups: []WaveGroup{{Enemy: config.EnemyFlyer, Count: 12, Interval: 0.65, PathID: "P1"}, {Enemy: config.EnemyShield, Count: 4, Interval: 1.00, PathID: "P0"}}},
 		},
 		EnemyHPMul: 1.12, EnemySpdMul: 1.0, BarrierEffect: "BARRIER_SLOW",
 	},
@@ -155,12 +155,12 @@ var Stages = []*StageDef{
 			{X: 0, Y: 4}, {X: 1, Y: 4}, {X: 2, Y: 4}, {X: 3, Y: 4}, {X: 4, Y: 4}, {X: 4, Y: 3}, {X: 4, Y: 2}, {X: 4, Y: 1}, {X: 5, Y: 1}, {X: 6, Y: 1}, {X: 7, Y: 1},
 		}}},
 		Waves: []WaveDef{
-			{ID: "W1", Groups: []WaveGroup{{config.EnemyRunner, 18, 0.55, "P0"}}},
-			{ID: "W2", Groups: []WaveGroup{{config.EnemyStalker, 8, 0.80, "P0"}}},
-			{ID: "W3", Groups: []WaveGroup{{config.EnemySplitter, 6, 0.90, "P0"}, {config.EnemyRunner, 8, 0.55, "P0"}}},
-			{ID: "W4", Groups: []WaveGroup{{config.EnemyStalker, 10, 0.70, "P0"}, {config.EnemyRunner, 10, 0.55, "P0"}}},
-			{ID: "W5", Groups: []WaveGroup{{config.EnemyShield, 5, 1.00, "P0"}, {config.EnemyStalker, 6, 0.80, "P0"}}},
-			{ID: "W6", Groups: []WaveGroup{{config.EnemyStalker, 12, 0.65, "P0"}, {config.EnemySplitter, 6, 0.90, "P0"}}},
+			{ID: "W1", Groups: []WaveGroup{{Enemy: config.EnemyRunner, Count: 18, Interval: 0.55, PathID: "P0"}}},
+			{ID: "W2", Groups: []WaveGroup{{Enemy: config.EnemyStalker, Count: 8, Interval: 0.80, PathID: "P0"}}},
+			{ID: "W3", Groups: []WaveGroup{{Enemy: config.EnemySplitter, Count: 6, Interval: 0.90, PathID: "P0"}, {Enemy: config.EnemyRunner, Count: 8, Interval: 0.55, PathID: "P0"}}},
+			{ID: "W4", Groups: []WaveGroup{{Enemy: config.EnemyStalker, Count: 10, Interval: 0.70, PathID: "P0"}, {Enemy: config.EnemyRunner, Count: 10, Interval: 0.55, PathID: "P0"}}},
+			{ID: "W5", Groups: []WaveGroup{{Enemy: config.EnemyShield, Count: 5, Interval: 1.00, PathID: "P0"}, {Enemy: config.EnemyStalker, Count: 6, Interval: 0.80, PathID: "P0"}}},
+			{ID: "W6", Groups: []WaveGroup{{Enemy: config.EnemyStalker, Count: 12, Interval: 0.65, PathID: "P0"}, {Enemy: config.EnemySplitter, Count: 6, Interval: 0.90, PathID: "P0"}}},
 		},
 		EnemyHPMul: 1.13, EnemySpdMul: 1.0, BarrierEffect: "BARRIER_REVEAL",
 	},
@@ -176,19 +176,19 @@ var Stages = []*StageDef{
 			{X: 0, Y: 6}, {X: 1, Y: 6}, {X: 2, Y: 6}, {X: 3, Y: 6}, {X: 4, Y: 6}, {X: 5, Y: 6}, {X: 5, Y: 5}, {X: 5, Y: 4}, {X: 5, Y: 3}, {X: 6, Y: 3}, {X: 7, Y: 3},
 		}}},
 		Waves: []WaveDef{
-			{ID: "W1", Groups: []WaveGroup{{config.EnemyRunner, 18, 0.55, "P0"}}},
-			{ID: "W2", Groups: []WaveGroup{{config.EnemyHacker, 5, 0.90, "P0"}, {config.EnemyRunner, 8, 0.55, "P0"}}},
-			{ID: "W3", Groups: []WaveGroup{{config.EnemyShield, 5, 1.00, "P0"}, {config.EnemyHacker, 4, 0.90, "P0"}}},
-			{ID: "W4", Groups: []WaveGroup{{config.EnemySplitter, 8, 0.90, "P0"}}},
-			{ID: "W5", Groups: []WaveGroup{{config.EnemyBruiser, 4, 1.10, "P0"}, {config.EnemyHacker, 6, 0.90, "P0"}}},
-			{ID: "W6", Groups: []WaveGroup{{config.EnemyHacker, 8, 0.80, "P0"}, {config.EnemyShield, 6, 1.00, "P0"}}},
+			{ID: "W1", Groups: []WaveGroup{{Enemy: config.EnemyRunner, Count: 18, Interval: 0.55, PathID: "P0"}}},
+			{ID: "W2", Groups: []WaveGroup{{Enemy: config.EnemyHacker, Count: 5, Interval: 0.90, PathID: "P0"}, {Enemy: config.EnemyRunner, Count: 8, Interval: 0.55, PathID: "P0"}}},
+			{ID: "W3", Groups: []WaveGroup{{Enemy: config.EnemyShield, Count: 5, Interval: 1.00, PathID: "P0"}, {Enemy: config.EnemyScramble, Count: 4, Interval: 0.90, PathID: "P0"}}},
+			{ID: "W4", Groups: []WaveGroup{{Enemy: config.EnemySplitter, Count: 8, Interval: 0.90, PathID: "P0"}, {Enemy: config.EnemyCharger, Count: 3, Interval: 1.80, PathID: "P0"}}},
+			{ID: "W5", Groups: []WaveGroup{{Enemy: config.EnemyBruiser, Count: 4, Interval: 1.10, PathID: "P0"}, {Enemy: config.EnemyThrottle, Count: 6, Interval: 0.90, PathID: "P0"}}},
+			{ID: "W6", Groups: []WaveGroup{{Enemy: config.EnemyHacker, Count: 8, Interval: 0.80, PathID: "P0"}, {Enemy: config.EnemyShield, Count: 6, Interval: 1.00, PathID: "P0"}}},
 		},
 		EnemyHPMul: 1.15, EnemySpdMul: 1.0, BarrierEffect: "BARRIER_SLOW",
 	},
 	{
 		ID: "CH1-10", Name: "Backroom Gatekeeper",
 		Integrity: 18, StartingGold: 14, StartingLv: 1, DeployCapBase: 3,
-		ShopRules: ShopRules{RerollEnabled: true, LevelUpEnabled: true, AllowedCosts: []int{1, 2, 3, 4}},
+		ShopRules: ShopRules{RerollEnabled: true, LevelUpEnabled: true, AllowedCosts: []int{1, 2, 3, 4}, XPPerWave: 3},
 		TriFuseEnabled: true, NodesEnabled: true,
 		Nodes:    []config.Pos{{X: 2, Y: 3}, {X: 4, Y: 6}, {X: 6, Y: 3}},
 		Specials: []SpecialTileDef{{Pos: config.Pos{X: 4, Y: 2}, Type: config.SpecialSeal}, {Pos: config.Pos{X: 4, Y: 5}, Type: config.SpecialWorkbench}},
@@ -197,13 +197,78 @@ var Stages = []*StageDef{
 			{X: 0, Y: 3}, {X: 1, Y: 3}, {X: 2, Y: 3}, {X: 3, Y: 3}, {X: 3, Y: 2}, {X: 3, Y: 1}, {X: 4, Y: 1}, {X: 5, Y: 1}, {X: 6, Y: 1}, {X: 6, Y: 2}, {X: 6, Y: 3}, {X: 6, Y: 4}, {X: 6, Y: 5}, {X: 7, Y: 5},
 		}}},
 		Waves: []WaveDef{
-			{ID: "W1", Groups: []WaveGroup{{config.EnemyRunner, 20, 0.50, "P0"}}},
-			{ID: "W2", Groups: []WaveGroup{{config.EnemyStalker, 8, 0.80, "P0"}, {config.EnemyRunner, 8, 0.55, "P0"}}},
-			{ID: "W3", Groups: []WaveGroup{{config.EnemyHacker, 6, 0.90, "P0"}, {config.EnemyShield, 4, 1.00, "P0"}}},
-			{ID: "W4", Groups: []WaveGroup{{config.EnemyBruiser, 5, 1.10, "P0"}, {config.EnemySplitter, 6, 0.90, "P0"}}},
-			{ID: "W5", Groups: []WaveGroup{{config.EnemyRunner, 12, 0.55, "P0"}, {config.EnemyFlyer, 8, 0.80, "P0"}}},
-			{ID: "W6", Groups: []WaveGroup{{config.EnemyBoss, 1, 0, "P0"}, {config.EnemyRunner, 12, 0.55, "P0"}}},
+			{ID: "W1", Groups: []WaveGroup{{Enemy: config.EnemyRunner, Count: 20, Interval: 0.50, PathID: "P0"}}},
+			{ID: "W2", Groups: []WaveGroup{{Enemy: config.EnemyStalker, Count: 8, Interval: 0.80, PathID: "P0"}, {Enemy: config.EnemyRunner, Count: 8, Interval: 0.55, PathID: "P0"}}},
+			{ID: "W3", Groups: []WaveGroup{{Enemy: config.EnemyHacker, Count: 6, Interval: 0.90, PathID: "P0"}, {Enemy: config.EnemyShield, Count: 4, Interval: 1.00, PathID: "P0"}}},
+			{ID: "W4", Groups: []WaveGroup{{Enemy: config.EnemyBruiser, Count: 5, Interval: 1.10, PathID: "P0"}, {Enemy: config.EnemySplitter, Count: 6, Interval: 0.90, PathID: "P0"}}},
+			{ID: "W5", Groups: []WaveGroup{{Enemy: config.EnemyRunner, Count: 12, Interval: 0.55, PathID: "P0"}, {Enemy: config.EnemyFlyer, Count: 8, Interval: 0.80, PathID: "P0"}, {Enemy: config.EnemyTotem, Count: 2, Interval: 3.00, PathID: "P0"}}},
+			{ID: "W6", Groups: []WaveGroup{{Enemy: config.EnemyBoss, Count: 1, Interval: 0, PathID: "P0"}, {Enemy: config.EnemyRunner, Count: 12, Interval: 0.55, PathID: "P0"}}},
 		},
 		EnemyHPMul: 1.18, EnemySpdMul: 1.0, BarrierEffect: "BARRIER_MARK",
 	},
+	{
+		ID: "EX-01", Name: "Open Floor",
+		Integrity: 20, StartingGold: 14, StartingLv: 2, DeployCapBase: 4,
+		ShopRules: ShopRules{RerollEnabled: true, LevelUpEnabled: true, AllowedCosts: []int{1, 2, 3}},
+		TriFuseEnabled: true, Maze: true,
+		Blocks: []config.Pos{{X: 3, Y: 0}, {X: 3, Y: 1}, {X: 4, Y: 6}, {X: 4, Y: 7}},
+		Paths: []PathDef{{ID: "P0", Waypoints: []config.Pos{{X: 0, Y: 0}, {X: 7, Y: 7}}}},
+		Waves: []WaveDef{
+			{ID: "W1", Groups: []WaveGroup{{Enemy: config.EnemyRunner, Count: 16, Interval: 0.55, PathID: "P0"}}},
+			{ID: "W2", Groups: []WaveGroup{{Enemy: config.EnemyRunner, Count: 10, Interval: 0.55, PathID: "P0"}, {Enemy: config.EnemyBruiser, Count: 3, Interval: 1.10, PathID: "P0"}}},
+			{ID: "W3", Groups: []WaveGroup{{Enemy: config.EnemySplitter, Count: 6, Interval: 0.90, PathID: "P0"}, {Enemy: config.EnemyRunner, Count: 8, Interval: 0.55, PathID: "P0"}}},
+			{ID: "W4", Groups: []WaveGroup{{Enemy: config.EnemyShield, Count: 6, Interval: 1.00, PathID: "P0"}, {Enemy: config.EnemyCharger, Count: 3, Interval: 1.80, PathID: "P0"}}},
+			{ID: "W5", Groups: []WaveGroup{{Enemy: config.EnemyBruiser, Count: 5, Interval: 1.10, PathID: "P0"}, {Enemy: config.EnemyRunner, Count: 12, Interval: 0.55, PathID: "P0"}}},
+			{ID: "W6", Groups: []WaveGroup{{Enemy: config.EnemyShield, Count: 6, Interval: 1.00, PathID: "P0"}, {Enemy: config.EnemySplitter, Count: 8, Interval: 0.90, PathID: "P0"}}},
+		},
+		EnemyHPMul: 1.1, EnemySpdMul: 1.0,
+	},
+	{
+		ID: "EX-02", Name: "Split Decision",
+		Integrity: 20, StartingGold: 14, StartingLv: 2, DeployCapBase: 4,
+		ShopRules: ShopRules{RerollEnabled: true, LevelUpEnabled: true, AllowedCosts: []int{1, 2, 3}},
+		TriFuseEnabled: true,
+		Blocks: []config.Pos{{X: 3, Y: 3}, {X: 4, Y: 3}},
+		Paths: []PathDef{
+			{ID: "P0", Waypoints: []config.Pos{{X: 0, Y: 3}, {X: 1, Y: 3}, {X: 2, Y: 3}},
+				Branches: []PathBranch{{PathID: "N", Weight: 2}, {PathID: "S", Weight: 1}}},
+			{ID: "N", Waypoints: []config.Pos{{X: 2, Y: 3}, {X: 2, Y: 2}, {X: 2, Y: 1}, {X: 3, Y: 1}, {X: 4, Y: 1}, {X: 5, Y: 1}, {X: 5, Y: 2}, {X: 5, Y: 3}},
+				Branches: []PathBranch{{PathID: "P1"}}},
+			{ID: "S", Waypoints: []config.Pos{{X: 2, Y: 3}, {X: 2, Y: 4}, {X: 2, Y: 5}, {X: 3, Y: 5}, {X: 4, Y: 5}, {X: 5, Y: 5}, {X: 5, Y: 4}, {X: 5, Y: 3}},
+				Branches: []PathBranch{{PathID: "P1"}}},
+			{ID: "P1", Waypoints: []config.Pos{{X: 5, Y: 3}, {X: 6, Y: 3}, {X: 7, Y: 3}},
+				Branches: []PathBranch{{PathID: "E0"}, {PathID: "E1"}}, BranchRule: config.BranchLeastDefended},
+			{ID: "E0", Waypoints: []config.Pos{{X: 7, Y: 3}, {X: 7, Y: 2}, {X: 7, Y: 1}, {X: 7, Y: 0}}},
+			{ID: "E1", Waypoints: []config.Pos{{X: 7, Y: 3}, {X: 7, Y: 4}, {X: 7, Y: 5}, {X: 7, Y: 6}, {X: 7, Y: 7}}},
+		},
+		Waves: []WaveDef{
+			{ID: "W1", Groups: []WaveGroup{{Enemy: config.EnemyRunner, Count: 16, Interval: 0.55, PathID: "P0"}}},
+			{ID: "W2", Groups: []WaveGroup{{Enemy: config.EnemyRunner, Count: 10, Interval: 0.55, PathID: "P0"}, {Enemy: config.EnemyBruiser, Count: 3, Interval: 1.10, PathID: "P0"}}},
+			{ID: "W3", Groups: []WaveGroup{{Enemy: config.EnemyShield, Count: 5, Interval: 1.00, PathID: "P0"}, {Enemy: config.EnemyRunner, Count: 8, Interval: 0.55, PathID: "P0"}}},
+			{ID: "W4", Groups: []WaveGroup{{Enemy: config.EnemyCharger, Count: 4, Interval: 1.60, PathID: "P0"}, {Enemy: config.EnemySplitter, Count: 6, Interval: 0.90, PathID: "P0"}}},
+			{ID: "W5", Groups: []WaveGroup{{Enemy: config.EnemyBruiser, Count: 5, Interval: 1.10, PathID: "P0"}, {Enemy: config.EnemyRunner, Count: 12, Interval: 0.55, PathID: "P0"}}},
+			{ID: "W6", Groups: []WaveGroup{{Enemy: config.EnemyShield, Count: 6, Interval: 1.00, PathID: "P0"}, {Enemy: config.EnemyCharger, Count: 4, Interval: 1.60, PathID: "P0"}}},
+		},
+		EnemyHPMul: 1.12, EnemySpdMul: 1.0,
+	},
+	{
+		ID: "EX-03", Name: "Clockwork",
+		Integrity: 20, StartingGold: 14, StartingLv: 2, DeployCapBase: 4,
+		ShopRules: ShopRules{RerollEnabled: true, LevelUpEnabled: true, AllowedCosts: []int{1, 2, 3}},
+		TriFuseEnabled: true,
+		Blocks: []config.Pos{{X: 3, Y: 2}, {X: 4, Y: 5}},
+		Paths: []PathDef{{ID: "P0", Waypoints: []config.Pos{
+			{X: 0, Y: 1}, {X: 1, Y: 1}, {X: 2, Y: 1}, {X: 3, Y: 1}, {X: 4, Y: 1}, {X: 5, Y: 1}, {X: 6, Y: 1}, {X: 6, Y: 2}, {X: 6, Y: 3}, {X: 6, Y: 4}, {X: 5, Y: 4}, {X: 4, Y: 4},
+			{X: 3, Y: 4}, {X: 2, Y: 4}, {X: 1, Y: 4}, {X: 1, Y: 5}, {X: 1, Y: 6}, {X: 2, Y: 6}, {X: 3, Y: 6}, {X: 4, Y: 6}, {X: 5, Y: 6}, {X: 6, Y: 6}, {X: 7, Y: 6},
+		}}},
+		Waves: []WaveDef{
+			{ID: "W1", Groups: []WaveGroup{{Enemy: config.EnemyRunner, Count: 12, Interval: 1.60, PathID: "P0", Formation: config.FormCluster, Size: 3}}},
+			{ID: "W2", Groups: []WaveGroup{{Enemy: config.EnemyBruiser, Count: 3, Interval: 1.10, PathID: "P0"}, {Enemy: config.EnemyRunner, Count: 12, Interval: 1.80, PathID: "P0", Delay: 1.5, After: 1, Formation: config.FormBurst, Size: 4}}},
+			{ID: "W3", Groups: []WaveGroup{{Enemy: config.EnemySplitter, Count: 6, Interval: 0.90, PathID: "P0"}, {Enemy: config.EnemyShield, Count: 4, Interval: 1.00, PathID: "P0", Delay: 3}}, SpdMul: 1.10},
+			{ID: "W4", Groups: []WaveGroup{{Enemy: config.EnemyCharger, Count: 4, Interval: 1.60, PathID: "P0"}, {Enemy: config.EnemyRunner, Count: 9, Interval: 2.00, PathID: "P0", Formation: config.FormCluster, Size: 3}}, Gold: 4},
+			{ID: "W5", Groups: []WaveGroup{{Enemy: config.EnemyShield, Count: 6, Interval: 1.00, PathID: "P0"}, {Enemy: config.EnemyRunner, Count: 12, Interval: 1.80, PathID: "P0", After: 1, Formation: config.FormCluster, Size: 4}, {Enemy: config.EnemyBruiser, Count: 4, Interval: 1.10, PathID: "P0", Delay: 2, After: 2}}, HPMul: 1.15},
+			{ID: "W6", Groups: []WaveGroup{{Enemy: config.EnemyBruiser, Count: 4, Interval: 1.10, PathID: "P0"}, {Enemy: config.EnemySplitter, Count: 8, Interval: 1.80, PathID: "P0", After: 1, Formation: config.FormBurst, Size: 4}, {Enemy: config.EnemyCharger, Count: 4, Interval: 1.60, PathID: "P0", Delay: 5}}, HPMul: 1.20, SpdMul: 1.05, Gold: 8},
+		},
+		EnemyHPMul: 1.12, EnemySpdMul: 1.0,
+	},
 }
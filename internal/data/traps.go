@@ -0,0 +1,26 @@
+package data
+
+import (
+	"image/color"
+
+	"neonsigil/internal/config"
+)
+
+// TrapDefs contains all trap definitions in shop order
+var TrapDefs = []*TrapDef{
+	{ID: "SPIKE", Name: "SPIKE", Kind: config.TrapSpike, Cost: 2,
+		Damage: 40, Charges: 6, Rearm: 1.0, Color: color.RGBA{200, 200, 220, 255}},
+	{ID: "SNARE", Name: "SNARE", Kind: config.TrapSnare, Cost: 2,
+		Stun: 1.5, Charges: 3, Rearm: 3.0, Color: color.RGBA{0, 220, 160, 255}},
+	{ID: "MINE", Name: "MINE", Kind: config.TrapMine, Cost: 3,
+		Damage: 150, Radius: 1.2, Charges: 1, Color: color.RGBA{255, 80, 40, 255}},
+}
+
+// TrapDefByID provides quick lookup
+var TrapDefByID = func() map[string]*TrapDef {
+	m := make(map[string]*TrapDef)
+	for _, t := range TrapDefs {
+		m[t.ID] = t
+	}
+	return m
+}()
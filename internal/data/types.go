@@ -15,24 +15,223 @@ type EnemyDef struct {
 	LeakDamage int
 	Color      color.RGBA
 	ShieldPct  float64 // ranged damage reduction (SHIELD type)
+	Movement   config.MoveMode
+	Stealth    bool // untargetable unless detected
+
+	// Disruption pulse (HACKER)
+	Disrupt         config.DisruptType
+	DisruptRadius   float64 // tiles
+	DisruptCooldown float64 // seconds between pulses
+	DisruptDuration float64 // seconds the effect lasts on units
+
+	// Telegraphed dash (CHARGER)
+	DashCooldown float64 // seconds of normal movement between dashes
+	DashWindup   float64 // seconds standing still while telegraphing
+	DashDistance float64 // tiles covered per dash
+	DashSpeedMul float64 // speed multiplier while dashing
+
+	Aura *AuraDef // buff granted to nearby enemies (TOTEM)
+	Boss *BossDef // phase script (bosses only)
+}
+
+// AuraDef describes the bonuses an aura carrier grants to other enemies in range.
+// Overlapping auras do not stack; each bonus takes the strongest source.
+type AuraDef struct {
+	Radius    float64 // tiles
+	DmgReduce float64 // fraction of incoming damage removed
+	SpeedMul  float64 // movement speed bonus (0.15 = +15%)
+	RegenPct  float64 // fraction of max HP regenerated per second
+}
+
+// BossDef is a boss phase script. Phases trigger in order as HP falls.
+type BossDef struct {
+	Phases []BossPhaseDef
+}
+
+// BossPhaseDef is one HP-threshold phase of a boss script
+type BossPhaseDef struct {
+	Name    string
+	HPPct   float64 // phase starts when HP drops to this fraction of max
+	Actions []BossAction
+}
+
+// BossAction is a single effect fired when a phase starts
+type BossAction struct {
+	Kind     config.BossActionType
+	Value    float64
+	Duration float64 // seconds (0 = until broken, for shields)
+	Enemy    config.EnemyType
+	Count    int
 }
 
 // UnitDef defines a unit template
 type UnitDef struct {
+	ID          string
+	Name        string
+	Cost        int
+	Faction     config.Faction
+	Class       config.UnitClass
+	HP          float64
+	ATK         float64
+	AtkSpeed    float64
+	Range       int
+	Armor       float64
+	AtkType     config.AttackType
+	DmgType     config.DamageType
+	Targeting   config.TargetMode
+	AirMul      float64        // damage multiplier vs air enemies (0 = cannot target air)
+	DetectRange int            // stealth detection radius in tiles (0 = none)
+	StarHPMul   float64        // HP multiplier per star-up (0 = DefaultStarHPMul)
+	StarATKMul  float64        // ATK multiplier per star-up (0 = DefaultStarATKMul)
+	Projectile  *ProjectileDef // ranged attack behavior (nil = single homing shot)
+	SkillDesc   string
+	Summon      *SummonDef // nil = no summon skill
+	TrapSkill   *TrapSkill // nil = lays no traps
+}
+
+// ProjectileDef describes how a ranged unit's attacks travel and hit
+type ProjectileDef struct {
+	Kind  config.ProjectileKind
+	Speed float64 // pixels per second (0 = default); ignored by beams
+	Color color.RGBA
+
+	Radius  float64 // SPLASH: radius in tiles
+	Falloff float64 // SPLASH: damage fraction at the edge of the radius
+
+	Pierce int // PIERCE: max enemies hit (0 = unlimited)
+
+	Chains       int     // CHAIN: extra jumps after the first hit
+	ChainRange   float64 // CHAIN: jump range in tiles
+	ChainFalloff float64 // CHAIN: damage multiplier per jump
+
+	OnHit *OnHitEffect // status applied to every enemy hit
+
+	OnLoss         config.TargetLossBehavior // target died mid-flight ("" = FIZZLE)
+	RetargetRadius float64                   // RETARGET: search radius in tiles
+
+	Zone string // SPLASH: ground zone left at the impact point (ZoneDefs key)
+}
+
+// SummonDef describes the temporary entity a unit creates on cooldown during
+// a wave. Summons never count against the deploy cap.
+type SummonDef struct {
+	Kind     config.SummonKind
+	Name     string
+	Cooldown float64 // seconds between summons
+	Duration float64 // seconds before expiring (0 = until the wave ends)
+	Max      int     // live summons per owner
+	HP       float64 // BLOCK: seconds it holds a 1-damage enemy (enemies wear it down by LeakDamage per second)
+	ATKMul   float64 // DRONE/TURRET: damage as a fraction of the owner's ATK
+	Range    float64 // DRONE/TURRET: attack range in tiles
+	AtkSpeed float64 // DRONE/TURRET: attacks per second
+	Orbit    float64 // DRONE: orbit radius around the owner in tiles
+	CDR      float64 // TURRET: faster summon cooldowns for units in range (0.3 = 30%)
+}
+
+// TrapDef defines a trap placed on a path tile. Traps trigger on ground
+// enemies stepping on them, spend a charge and rearm.
+type TrapDef struct {
+	ID      string
+	Name    string
+	Kind    config.TrapType
+	Cost    int
+	Damage  float64 // SPIKE/MINE
+	Radius  float64 // MINE: blast radius in tiles
+	Stun    float64 // SNARE: stun duration
+	Charges int     // triggers before the trap is used up
+	Rearm   float64 // seconds between triggers
+	Color   color.RGBA
+}
+
+// TrapSkill lets a unit lay a free trap on a path tile in range
+type TrapSkill struct {
+	Trap     string  // TrapDefs ID
+	Cooldown float64 // seconds between traps, one live trap per unit
+}
+
+// ZoneDef defines a temporary ground effect centered on a tile
+type ZoneDef struct {
+	ID       string
+	Name     string
+	Kind     config.ZoneType
+	Radius   float64 // tiles (0.5 = the tile itself)
+	Duration float64
+	TickRate float64 // DOT: seconds between damage ticks
+	// DOT: damage per tick, multiplied by the creator's ATK for unit skills.
+	// SLOW: speed reduction (0.4 = 40% slower). VULNERABLE: extra damage taken.
+	Value     float64
+	Stacking  config.ZoneStacking
+	MaxStacks int  // INTENSIFY cap
+	HitsAir   bool // also affects flying enemies
+	Color     color.RGBA
+}
+
+// ItemDef defines an item component or a finished item crafted from two components
+type ItemDef struct {
 	ID        string
 	Name      string
-	Cost      int
-	Faction   config.Faction
-	Class     config.UnitClass
-	HP        float64
-	ATK       float64
-	AtkSpeed  float64
-	Range     int
-	Armor     float64
-	AtkType   config.AttackType
-	DmgType   config.DamageType
-	Targeting config.TargetMode
-	SkillDesc string
+	Component bool
+	Recipe    [2]string // component IDs (finished items only)
+	Color     color.RGBA
+
+	ATKPct      float64 // attack bonus (0.1 = +10%)
+	AtkSpeedPct float64 // attack speed bonus
+	RangeBonus  int
+	AirMulBonus float64 // added to the unit's anti-air multiplier
+	DisruptRes  float64 // disruption duration reduction
+	OnHit       *OnHitEffect
+
+	Consumable bool   // used up from the inventory instead of equipped
+	Zone       string // consumable: ground zone placed on a path tile
+}
+
+// OnHitEffect is a status applied to the target of every Every-th attack
+type OnHitEffect struct {
+	Kind     config.StatusType
+	Value    float64 // MARK: extra damage taken
+	Duration float64
+	Every    int // 0 or 1 = every hit
+}
+
+// SpecialDef defines what a special tile type does. Unit bonuses apply to
+// the unit standing on the tile; area effects are centered on the tile.
+type SpecialDef struct {
+	Type config.SpecialType
+	Name string
+	Desc string // tooltip text
+
+	NeedsUnit bool    // area effect only active while a unit stands on the tile
+	Radius    float64 // area effect radius in tiles
+	HaltTime  float64 // stun applied once to each enemy entering the area
+	Slows     bool    // enemies inside the area are slowed
+
+	RevealRadius int // stealth detection radius in tiles (always active)
+
+	RangeBonus    int     // range bonus for the unit on the tile
+	DisruptResist float64 // disruption duration reduction for the unit on the tile
+}
+
+// BarrierDef defines a node barrier: what it does and how often it can fire
+type BarrierDef struct {
+	ID            string
+	Name          string
+	Duration      float64 // seconds the barrier visual stays up
+	Cooldown      float64 // seconds before it can fire again
+	NodesRequired int     // occupied nodes needed (0 = every node on the stage)
+	ChargeTime    float64 // seconds the network must stay complete before it can fire
+	Radius        float64 // targeted area in tiles (0 = whole board)
+	Effects       []BarrierEffect
+}
+
+// BarrierEffect is one effect of a barrier. Faction-gated effects only apply
+// when a unit of that faction stands on one of the occupied nodes.
+type BarrierEffect struct {
+	Kind     config.BarrierEffectType
+	Target   config.BarrierTarget
+	Value    float64
+	Duration float64
+	Faction  config.Faction // "" = always
+	Zone     string         // ZONE: ZoneDefs key
 }
 
 // SpecialTileDef defines a special tile on the map
@@ -47,18 +246,39 @@ type WaveGroup struct {
 	Count    int
 	Interval float64 // seconds between spawns
 	PathID   string
+
+	// Scripting; zero values start the group at wave start, one at a time
+	Delay     float64               // seconds between the group's release and its first spawn
+	After     int                   // release once group After (1-based) is fully spawned and cleared, 0 = at wave start
+	Formation config.SpawnFormation // "" = SINGLE
+	Size      int                   // CLUSTER/BURST: enemies per formation (0 = config.DefaultFormationSize)
 }
 
 // WaveDef defines a complete wave
 type WaveDef struct {
 	ID     string
 	Groups []WaveGroup
+
+	HPMul  float64 // enemy HP multiplier on top of the stage's (0 = 1)
+	SpdMul float64 // enemy speed multiplier on top of the stage's (0 = 1)
+	Gold   int     // bonus gold for finishing the wave
 }
 
-// PathDef defines an enemy path
+// PathDef defines an enemy path. A path with Branches forks at its last
+// waypoint: each branch path starts on that tile, and several branches may
+// continue into the same path to merge again.
 type PathDef struct {
-	ID        string
-	Waypoints []config.Pos
+	ID         string
+	Waypoints  []config.Pos
+	Air        bool // air lane: sparse waypoints, tiles stay buildable
+	Branches   []PathBranch
+	BranchRule config.BranchRule // "" = WEIGHTED
+}
+
+// PathBranch is one way out of a path fork
+type PathBranch struct {
+	PathID string
+	Weight float64 // WEIGHTED: relative chance (0 = 1)
 }
 
 // ShopRules for a stage
@@ -66,6 +286,12 @@ type ShopRules struct {
 	RerollEnabled  bool
 	LevelUpEnabled bool
 	AllowedCosts   []int
+
+	// Experience; zero values fall back to the defaults
+	XPCurve     []int // XP needed to advance from each level (index = level), nil = data.XPCurve
+	XPBuyCost   int   // gold per XP purchase
+	XPBuyAmount int   // XP per purchase
+	XPPerWave   int   // passive XP after each wave
 }
 
 // StageDef defines a complete stage
@@ -83,8 +309,21 @@ type StageDef struct {
 	Nodes          []config.Pos
 	Specials       []SpecialTileDef
 	Paths          []PathDef
+	Maze           bool // ground paths list only spawn and exit; enemies route around units with A*
 	Waves          []WaveDef
 	EnemyHPMul     float64
 	EnemySpdMul    float64
 	BarrierEffect  string
+	Economy        *EconomyDef // nil = DefaultEconomy
+}
+
+// EconomyDef configures kill gold, wave income, interest and streaks
+type EconomyDef struct {
+	KillGold        int   // gold per kill
+	WaveBase        int   // flat gold per cleared wave
+	WaveScale       int   // extra gold per wave number
+	InterestStep    int   // 1 gold interest per this much banked gold (0 = no interest)
+	InterestCap     int   // max interest per wave
+	WinStreakBonus  []int // bonus by no-leak streak length (index = streak, clamped)
+	LossStreakBonus []int // compensation by leaking streak length
 }
@@ -1,6 +1,10 @@
 package data
 
-import "neonsigil/internal/config"
+import (
+	"image/color"
+
+	"neonsigil/internal/config"
+)
 
 // UnitDefs contains all unit definitions
 var UnitDefs = []*UnitDef{
@@ -8,81 +12,114 @@ var UnitDefs = []*UnitDef{
 	{ID: "MOTH", Name: "MOTH", Cost: 1, Faction: config.FactionStreet, Class: config.ClassVanguard,
 		HP: 520, ATK: 38, AtkSpeed: 1.0, Range: 1, Armor: 10,
 		AtkType: config.AttackMelee, DmgType: config.DamagePhys, Targeting: config.TargetFrontmost,
-		SkillDesc: "Taunt + DMG Reduction"},
+		StarHPMul: 1.8, StarATKMul: 1.25, SkillDesc: "Taunt + DMG Reduction"},
 	{ID: "VICE", Name: "VICE", Cost: 1, Faction: config.FactionStreet, Class: config.ClassMarksman,
 		HP: 280, ATK: 55, AtkSpeed: 1.2, Range: 3, Armor: 3,
-		AtkType: config.AttackRanged, DmgType: config.DamagePhys, Targeting: config.TargetLowHP,
+		AtkType: config.AttackRanged, DmgType: config.DamagePhys, Targeting: config.TargetLowHP, AirMul: 1.25,
 		SkillDesc: "Backline Strike"},
 	{ID: "KNOT", Name: "KNOT", Cost: 1, Faction: config.FactionCoven, Class: config.ClassCaster,
 		HP: 300, ATK: 48, AtkSpeed: 0.9, Range: 3, Armor: 4,
-		AtkType: config.AttackRanged, DmgType: config.DamageMagic, Targeting: config.TargetFrontmost,
+		AtkType: config.AttackRanged, DmgType: config.DamageMagic, Targeting: config.TargetFrontmost, AirMul: 1.0,
 		SkillDesc: "Curse Stack"},
 	{ID: "TAR", Name: "TAR", Cost: 1, Faction: config.FactionCoven, Class: config.ClassSupport,
 		HP: 350, ATK: 30, AtkSpeed: 0.8, Range: 2, Armor: 5,
-		AtkType: config.AttackRanged, DmgType: config.DamageMagic, Targeting: config.TargetFrontmost,
-		SkillDesc: "Slow Charm"},
+		AtkType: config.AttackRanged, DmgType: config.DamageMagic, Targeting: config.TargetFrontmost, AirMul: 0.75,
+		SkillDesc: "Slow Charm", TrapSkill: &TrapSkill{Trap: "SNARE", Cooldown: 12}},
 	{ID: "SPARK", Name: "SPARK", Cost: 1, Faction: config.FactionArcTech, Class: config.ClassEngineer,
 		HP: 300, ATK: 42, AtkSpeed: 1.0, Range: 2, Armor: 5,
-		AtkType: config.AttackRanged, DmgType: config.DamagePhys, Targeting: config.TargetNearest,
-		SkillDesc: "Deploy Drone"},
+		AtkType: config.AttackRanged, DmgType: config.DamagePhys, Targeting: config.TargetNearest, AirMul: 1.0,
+		SkillDesc: "Deploy Drone",
+		Summon: &SummonDef{Kind: config.SummonDrone, Name: "DRONE", Cooldown: 8, Duration: 12, Max: 2,
+			ATKMul: 0.4, Range: 2, AtkSpeed: 1.5, Orbit: 0.7}},
 	{ID: "GLINT", Name: "GLINT", Cost: 1, Faction: config.FactionArcTech, Class: config.ClassMarksman,
 		HP: 260, ATK: 58, AtkSpeed: 1.3, Range: 4, Armor: 2,
-		AtkType: config.AttackRanged, DmgType: config.DamagePhys, Targeting: config.TargetFrontmost,
+		AtkType: config.AttackRanged, DmgType: config.DamagePhys, Targeting: config.TargetFrontmost, AirMul: 1.25,
 		SkillDesc: "Single Shot"},
 	{ID: "HALO", Name: "HALO", Cost: 1, Faction: config.FactionExorcist, Class: config.ClassSupport,
 		HP: 380, ATK: 25, AtkSpeed: 0.7, Range: 2, Armor: 6,
-		AtkType: config.AttackRanged, DmgType: config.DamageMagic, Targeting: config.TargetNearest,
-		SkillDesc: "Purify Shield"},
+		AtkType: config.AttackRanged, DmgType: config.DamageMagic, Targeting: config.TargetNearest, AirMul: 0.75,
+		DetectRange: 2, SkillDesc: "Purify Shield"},
 	{ID: "IRON", Name: "IRON", Cost: 1, Faction: config.FactionExorcist, Class: config.ClassVanguard,
 		HP: 580, ATK: 32, AtkSpeed: 0.9, Range: 1, Armor: 14,
 		AtkType: config.AttackMelee, DmgType: config.DamagePhys, Targeting: config.TargetFrontmost,
-		SkillDesc: "DMG Reduction + Block"},
+		StarHPMul: 1.8, StarATKMul: 1.25, SkillDesc: "DMG Reduction + Block"},
 
 	// 2-cost (6)
 	{ID: "GLASS", Name: "GLASS", Cost: 2, Faction: config.FactionStreet, Class: config.ClassMarksman,
 		HP: 320, ATK: 72, AtkSpeed: 1.1, Range: 4, Armor: 3,
-		AtkType: config.AttackRanged, DmgType: config.DamagePhys, Targeting: config.TargetLowHP,
+		AtkType: config.AttackRanged, DmgType: config.DamagePhys, Targeting: config.TargetLowHP, AirMul: 1.25,
+		Projectile: &ProjectileDef{Kind: config.ProjPierce, Speed: 700, Color: color.RGBA{255, 80, 80, 255}, Pierce: 3,
+			OnHit: &OnHitEffect{Kind: config.StatusMark, Value: 0.15, Duration: 2}},
 		SkillDesc: "Mark Snipe"},
 	{ID: "INK", Name: "INK", Cost: 2, Faction: config.FactionCoven, Class: config.ClassCaster,
 		HP: 360, ATK: 60, AtkSpeed: 0.85, Range: 3, Armor: 5,
-		AtkType: config.AttackRanged, DmgType: config.DamageMagic, Targeting: config.TargetFrontmost,
+		AtkType: config.AttackRanged, DmgType: config.DamageMagic, Targeting: config.TargetFrontmost, AirMul: 1.0,
+		Projectile: &ProjectileDef{Kind: config.ProjSplash, Speed: 280, Color: color.RGBA{160, 60, 255, 255}, Radius: 1.0, Falloff: 0.5,
+			OnHit: &OnHitEffect{Kind: config.StatusSlow, Duration: 2.0}, OnLoss: config.LossImpact, Zone: "INK_POOL"},
 		SkillDesc: "Lingering Zone"},
 	{ID: "PATCH", Name: "PATCH", Cost: 2, Faction: config.FactionArcTech, Class: config.ClassEngineer,
 		HP: 400, ATK: 35, AtkSpeed: 0.8, Range: 2, Armor: 8,
-		AtkType: config.AttackRanged, DmgType: config.DamagePhys, Targeting: config.TargetNearest,
+		AtkType: config.AttackRanged, DmgType: config.DamagePhys, Targeting: config.TargetNearest, AirMul: 1.0,
 		SkillDesc: "Repair Module"},
 	{ID: "VOLT", Name: "VOLT", Cost: 2, Faction: config.FactionArcTech, Class: config.ClassCaster,
 		HP: 340, ATK: 65, AtkSpeed: 0.9, Range: 3, Armor: 4,
-		AtkType: config.AttackRanged, DmgType: config.DamageMagic, Targeting: config.TargetFrontmost,
+		AtkType: config.AttackRanged, DmgType: config.DamageMagic, Targeting: config.TargetFrontmost, AirMul: 1.0,
+		Projectile: &ProjectileDef{Kind: config.ProjChain, Speed: 600, Color: color.RGBA{255, 255, 80, 255},
+			Chains: 3, ChainRange: 1.5, ChainFalloff: 0.7,
+			OnHit: &OnHitEffect{Kind: config.StatusStun, Duration: 0.2}, OnLoss: config.LossRetarget, RetargetRadius: 1.5},
 		SkillDesc: "Shock AoE"},
 	{ID: "LAMP", Name: "LAMP", Cost: 2, Faction: config.FactionExorcist, Class: config.ClassMarksman,
 		HP: 300, ATK: 68, AtkSpeed: 1.2, Range: 4, Armor: 3,
-		AtkType: config.AttackRanged, DmgType: config.DamageMagic, Targeting: config.TargetFrontmost,
-		SkillDesc: "Undead Bane"},
+		AtkType: config.AttackRanged, DmgType: config.DamageMagic, Targeting: config.TargetFrontmost, AirMul: 1.25,
+		DetectRange: 3, SkillDesc: "Undead Bane"},
 	{ID: "LITANY", Name: "LITANY", Cost: 2, Faction: config.FactionExorcist, Class: config.ClassCaster,
 		HP: 380, ATK: 58, AtkSpeed: 0.8, Range: 3, Armor: 6,
-		AtkType: config.AttackRanged, DmgType: config.DamageMagic, Targeting: config.TargetFrontmost,
+		AtkType: config.AttackRanged, DmgType: config.DamageMagic, Targeting: config.TargetFrontmost, AirMul: 1.0,
+		Projectile: &ProjectileDef{Kind: config.ProjSplash, Speed: 350, Color: color.RGBA{255, 240, 180, 255}, Radius: 1.0, Falloff: 0.6,
+			OnLoss: config.LossImpact},
 		SkillDesc: "Purify AoE"},
 
 	// 3-cost (3)
 	{ID: "COIN", Name: "COIN", Cost: 3, Faction: config.FactionStreet, Class: config.ClassSupport,
 		HP: 420, ATK: 40, AtkSpeed: 0.7, Range: 2, Armor: 6,
-		AtkType: config.AttackRanged, DmgType: config.DamagePhys, Targeting: config.TargetNearest,
+		AtkType: config.AttackRanged, DmgType: config.DamagePhys, Targeting: config.TargetNearest, AirMul: 0.75,
 		SkillDesc: "Kill Gold + Buff"},
 	{ID: "DOLL", Name: "DOLL", Cost: 3, Faction: config.FactionCoven, Class: config.ClassCaster,
 		HP: 450, ATK: 55, AtkSpeed: 0.75, Range: 3, Armor: 7,
-		AtkType: config.AttackRanged, DmgType: config.DamageMagic, Targeting: config.TargetFrontmost,
-		SkillDesc: "Summon Block"},
+		AtkType: config.AttackRanged, DmgType: config.DamageMagic, Targeting: config.TargetFrontmost, AirMul: 1.0,
+		SkillDesc: "Summon Block",
+		Summon:    &SummonDef{Kind: config.SummonBlock, Name: "BLOCK", Cooldown: 10, Duration: 8, Max: 1, HP: 5}},
 	{ID: "NODE", Name: "NODE", Cost: 3, Faction: config.FactionArcTech, Class: config.ClassSupport,
 		HP: 480, ATK: 30, AtkSpeed: 0.6, Range: 2, Armor: 9,
-		AtkType: config.AttackRanged, DmgType: config.DamagePhys, Targeting: config.TargetNearest,
-		SkillDesc: "Deploy + CDR"},
+		AtkType: config.AttackRanged, DmgType: config.DamagePhys, Targeting: config.TargetNearest, AirMul: 0.75,
+		SkillDesc: "Deploy + CDR",
+		Summon: &SummonDef{Kind: config.SummonTurret, Name: "TURRET", Cooldown: 15, Max: 1,
+			ATKMul: 0.6, Range: 2, AtkSpeed: 1.0, CDR: 0.3}},
 
 	// 4-cost (1)
 	{ID: "ORISON", Name: "ORISON", Cost: 4, Faction: config.FactionExorcist, Class: config.ClassCaster,
 		HP: 500, ATK: 85, AtkSpeed: 0.6, Range: 3, Armor: 8,
-		AtkType: config.AttackRanged, DmgType: config.DamageMagic, Targeting: config.TargetFrontmost,
-		SkillDesc: "Grand Purify"},
+		AtkType: config.AttackRanged, DmgType: config.DamageMagic, Targeting: config.TargetFrontmost, AirMul: 1.0,
+		Projectile: &ProjectileDef{Kind: config.ProjBeam, Color: color.RGBA{255, 255, 220, 255}},
+		StarHPMul:  1.5, StarATKMul: 1.5, SkillDesc: "Grand Purify"},
+}
+
+// Default star-up multipliers for units without their own scaling
+const (
+	DefaultStarHPMul  = 1.6
+	DefaultStarATKMul = 1.35
+)
+
+// StarMultipliers returns the unit's per-star-up HP and ATK multipliers
+func StarMultipliers(def *UnitDef) (hp, atk float64) {
+	hp, atk = def.StarHPMul, def.StarATKMul
+	if hp == 0 {
+		hp = DefaultStarHPMul
+	}
+	if atk == 0 {
+		atk = DefaultStarATKMul
+	}
+	return hp, atk
 }
 
 // UnitDefByID provides quick lookup
@@ -0,0 +1,35 @@
+package data
+
+import (
+	"image/color"
+
+	"neonsigil/internal/config"
+)
+
+// ZoneDefs contains all ground zone definitions
+var ZoneDefs = map[string]*ZoneDef{
+	"INK_POOL": {
+		ID: "INK_POOL", Name: "INK POOL", Kind: config.ZoneDamage,
+		Radius: 0.6, Duration: 3, TickRate: 0.5, Value: 0.12,
+		Stacking: config.StackIntensify, MaxStacks: 3,
+		Color: color.RGBA{160, 60, 255, 255},
+	},
+	"TAR_FIELD": {
+		ID: "TAR_FIELD", Name: "TAR FIELD", Kind: config.ZoneSlow,
+		Radius: 0.5, Duration: 8, Value: 0.45,
+		Stacking: config.StackRefresh,
+		Color:    color.RGBA{120, 90, 40, 255},
+	},
+	"NAPALM": {
+		ID: "NAPALM", Name: "NAPALM", Kind: config.ZoneDamage,
+		Radius: 0.5, Duration: 6, TickRate: 0.5, Value: 20,
+		Stacking: config.StackRefresh,
+		Color:    color.RGBA{255, 110, 0, 255},
+	},
+	"HEX_FIELD": {
+		ID: "HEX_FIELD", Name: "HEX FIELD", Kind: config.ZoneVulnerable,
+		Radius: 1.5, Duration: 5, Value: 0.2,
+		Stacking: config.StackIndependent, HitsAir: true,
+		Color: color.RGBA{255, 0, 255, 255},
+	},
+}
@@ -0,0 +1,106 @@
+package economy
+
+import (
+	"neonsigil/internal/data"
+)
+
+// Economy tracks interest and streaks and works out wave income
+type Economy struct {
+	Def        *data.EconomyDef
+	WinStreak  int // consecutive waves cleared without a leak
+	LossStreak int // consecutive waves with at least one leak
+	Interest   int // interest locked in when the current wave started
+	Waves      []data.WaveDef
+}
+
+// Breakdown itemises the gold paid at the end of a wave
+type Breakdown struct {
+	Base     int
+	Interest int
+	Streak   int
+	Reward   int // the wave's own bonus gold
+}
+
+// Total returns the sum of all income sources
+func (b Breakdown) Total() int {
+	return b.Base + b.Interest + b.Streak + b.Reward
+}
+
+// NewEconomy creates the economy for the given stage
+func NewEconomy(stage *data.StageDef) *Economy {
+	def := stage.Economy
+	if def == nil {
+		def = data.DefaultEconomy
+	}
+	return &Economy{Def: def, Waves: stage.Waves}
+}
+
+// KillGold returns the gold awarded per kill
+func (e *Economy) KillGold() int {
+	return e.Def.KillGold
+}
+
+// InterestOn returns the interest earned by banking the given gold
+func (e *Economy) InterestOn(gold int) int {
+	if e.Def.InterestStep <= 0 {
+		return 0
+	}
+	return min(gold/e.Def.InterestStep, e.Def.InterestCap)
+}
+
+// StartWave locks in interest on the gold banked when the wave starts
+func (e *Economy) StartWave(gold int) {
+	e.Interest = e.InterestOn(gold)
+}
+
+// reward returns the bonus gold of the given wave (1-based)
+func (e *Economy) reward(wave int) int {
+	if wave < 1 || wave > len(e.Waves) {
+		return 0
+	}
+	return e.Waves[wave-1].Gold
+}
+
+// streakBonus looks up a streak table, clamping to its last entry
+func streakBonus(table []int, streak int) int {
+	if len(table) == 0 {
+		return 0
+	}
+	return table[min(streak, len(table)-1)]
+}
+
+// Preview returns the income for finishing the given wave (1-based) with
+// the given gold banked, assuming a clean or leaking result
+func (e *Economy) Preview(wave, gold int, clean bool) Breakdown {
+	b := Breakdown{
+		Base:     e.Def.WaveBase + e.Def.WaveScale*wave,
+		Interest: e.InterestOn(gold),
+		Reward:   e.reward(wave),
+	}
+	if clean {
+		b.Streak = streakBonus(e.Def.WinStreakBonus, e.WinStreak+1)
+	} else {
+		b.Streak = streakBonus(e.Def.LossStreakBonus, e.LossStreak+1)
+	}
+	return b
+}
+
+// EndWave updates the streaks from the wave's leaks and returns its income
+func (e *Economy) EndWave(wave, leaks int) Breakdown {
+	b := Breakdown{
+		Base:     e.Def.WaveBase + e.Def.WaveScale*wave,
+		Interest: e.Interest,
+		Reward:   e.reward(wave),
+	}
+	if leaks == 0 {
+		e.WinStreak++
+		e.LossStreak = 0
+		b.Streak = streakBonus(e.Def.WinStreakBonus, e.WinStreak)
+	} else {
+		e.LossStreak++
+		e.WinStreak = 0
+		b.Streak = streakBonus(e.Def.LossStreakBonus, e.LossStreak)
+	}
+	e.Interest = 0
+	return b
+}
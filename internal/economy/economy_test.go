@@ -0,0 +1,134 @@
+package economy
+
+import (
+	"testing"
+
+	"neonsigil/internal/data"
+)
+
+// testDef is a fixed rule set so the tests do not follow stage tuning
+var testDef = &data.EconomyDef{
+	KillGold:        1,
+	WaveBase:        3,
+	WaveScale:       1,
+	InterestStep:    10,
+	InterestCap:     5,
+	WinStreakBonus:  []int{0, 0, 1, 2},
+	LossStreakBonus: []int{0, 1, 1, 3},
+}
+
+func TestNewEconomyDefault(t *testing.T) {
+	e := NewEconomy(&data.StageDef{})
+	if e.Def != data.DefaultEconomy {
+		t.Fatalf("stage without rules got %+v, want DefaultEconomy", e.Def)
+	}
+	e = NewEconomy(&data.StageDef{Economy: testDef})
+	if e.Def != testDef {
+		t.Fatalf("stage rules were not used")
+	}
+}
+
+func TestInterestOn(t *testing.T) {
+	tests := []struct {
+		name string
+		def  *data.EconomyDef
+		gold int
+		want int
+	}{
+		{"below one step", testDef, 9, 0},
+		{"one step", testDef, 10, 1},
+		{"rounds down", testDef, 39, 3},
+		{"at cap", testDef, 50, 5},
+		{"over cap", testDef, 200, 5},
+		{"broke", testDef, 0, 0},
+		{"disabled", &data.EconomyDef{InterestCap: 5}, 100, 0},
+	}
+	for _, tt := range tests {
+		e := &Economy{Def: tt.def}
+		if got := e.InterestOn(tt.gold); got != tt.want {
+			t.Errorf("%s: InterestOn(%d) = %d, want %d", tt.name, tt.gold, got, tt.want)
+		}
+	}
+}
+
+func TestStreakBonus(t *testing.T) {
+	table := []int{0, 0, 1, 2}
+	tests := []struct {
+		table  []int
+		streak int
+		want   int
+	}{
+		{table, 0, 0},
+		{table, 2, 1},
+		{table, 3, 2},
+		{table, 4, 2},  // clamped to the last entry
+		{table, 99, 2}, // clamped to the last entry
+		{nil, 5, 0},
+	}
+	for _, tt := range tests {
+		if got := streakBonus(tt.table, tt.streak); got != tt.want {
+			t.Errorf("streakBonus(%v, %d) = %d, want %d", tt.table, tt.streak, got, tt.want)
+		}
+	}
+}
+
+func TestPreview(t *testing.T) {
+	tests := []struct {
+		name       string
+		win, loss  int
+		wave, gold int
+		clean      bool
+		want       Breakdown
+	}{
+		{"first clean wave", 0, 0, 1, 0, true, Breakdown{Base: 4}},
+		{"clean extends streak", 1, 0, 3, 25, true, Breakdown{Base: 6, Interest: 2, Streak: 1}},
+		{"leak resets win streak", 2, 0, 3, 25, false, Breakdown{Base: 6, Interest: 2, Streak: 1}},
+		{"leak extends loss streak", 0, 2, 4, 60, false, Breakdown{Base: 7, Interest: 5, Streak: 3}},
+	}
+	for _, tt := range tests {
+		e := &Economy{Def: testDef, WinStreak: tt.win, LossStreak: tt.loss}
+		got := e.Preview(tt.wave, tt.gold, tt.clean)
+		if got != tt.want {
+			t.Errorf("%s: Preview = %+v, want %+v", tt.name, got, tt.want)
+		}
+		if e.WinStreak != tt.win || e.LossStreak != tt.loss {
+			t.Errorf("%s: Preview changed the streaks", tt.name)
+		}
+	}
+}
+
+func TestEndWave(t *testing.T) {
+	e := &Economy{Def: testDef}
+	steps := []struct {
+		gold, leaks int
+		want        Breakdown
+		win, loss   int
+	}{
+		{0, 0, Breakdown{Base: 4}, 1, 0},
+		{15, 0, Breakdown{Base: 5, Interest: 1, Streak: 1}, 2, 0},
+		{30, 0, Breakdown{Base: 6, Interest: 3, Streak: 2}, 3, 0},
+		{30, 2, Breakdown{Base: 7, Interest: 3, Streak: 1}, 0, 1},
+		{90, 1, Breakdown{Base: 8, Interest: 5, Streak: 1}, 0, 2},
+		{0, 0, Breakdown{Base: 9}, 1, 0},
+	}
+	for i, s := range steps {
+		e.StartWave(s.gold)
+		got := e.EndWave(i+1, s.leaks)
+		if got != s.want {
+			t.Errorf("wave %d: EndWave = %+v, want %+v", i+1, got, s.want)
+		}
+		if e.WinStreak != s.win || e.LossStreak != s.loss {
+			t.Errorf("wave %d: streaks = %d/%d, want %d/%d", i+1, e.WinStreak, e.LossStreak, s.win, s.loss)
+		}
+		if e.Interest != 0 {
+			t.Errorf("wave %d: locked interest was not cleared", i+1)
+		}
+	}
+}
+
+func TestBreakdownTotal(t *testing.T) {
+	b := Breakdown{Base: 5, Interest: 3, Streak: 2}
+	if got := b.Total(); got != 10 {
+		t.Fatalf("Total = %d, want 10", got)
+	}
+}
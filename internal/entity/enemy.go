@@ -20,34 +20,67 @@ type Enemy struct {
 	Pos         config.FPos // pixel position
 	PathID      string
 	WaypointIdx int
+	Traveled    int  // waypoints passed on earlier paths before forks
+	AtFork      bool // waiting at the end of a forking path for a branch choice
+	Serial      int  // spawn order within the wave
 	Speed       float64
 	Alive       bool
 	Reached     bool // reached the end
 	SlowTimer   float64
 	StunTimer   float64
-	Visible     bool // for STALKER
+	Visible     bool    // false while stealthed and undetected
+	RevealTimer float64 // forced reveal time left (barriers, skills)
+
+	DisruptTimer float64 // time until next disruption pulse
+	PulseFx      float64 // remaining pulse ring animation time
+
+	DashTimer   float64     // time until next dash telegraph
+	WindupTimer float64     // remaining telegraph time (standing still)
+	DashLeft    float64     // remaining dash distance in pixels
+	DashDir     config.FPos // unit vector of the telegraphed/current dash
+
+	Buff AuraBuff // aura bonuses from nearby carriers, recomputed every tick
+	Zone ZoneFx   // ground zone effects underfoot, recomputed every tick
+
+	Blocked bool // held in place by a summoned block, recomputed every tick
+
+	SealHalted bool    // already halted by a SEAL tile
+	MarkTimer  float64 // remaining mark time
+	MarkMul    float64 // extra damage taken while marked (0.25 = +25%)
+
+	// Boss script state
+	PhaseIdx    int     // number of boss phases already entered
+	Shield      float64 // damage absorbed before HP
+	ShieldTimer float64 // remaining shield time (0 = until broken)
+	BurstMul    float64
+	BurstTimer  float64
+}
+
+// AuraBuff holds the aura bonuses currently applied to an enemy
+type AuraBuff struct {
+	DmgReduce float64
+	SpeedMul  float64
+	RegenPct  float64
+}
+
+// Active reports whether any aura bonus is applied
+func (a AuraBuff) Active() bool {
+	return a.DmgReduce > 0 || a.SpeedMul > 0 || a.RegenPct > 0
 }
 
 // NewEnemy creates a new enemy from a definition
 func NewEnemy(def *data.EnemyDef, pathID string, hpMul, spdMul float64, b *board.Board) *Enemy {
 	// Find starting position from path
 	var startPos config.FPos
-	for _, pd := range b.PathDefs {
-		if pd.ID == pathID && len(pd.Waypoints) > 0 {
-			wp := pd.Waypoints[0]
-			startPos = config.FPos{
-				X: float64(config.BoardOffsetX+wp.X*config.TileSize) + float64(config.TileSize)/2,
-				Y: float64(config.BoardOffsetY+wp.Y*config.TileSize) + float64(config.TileSize)/2,
-			}
-			break
+	if pd := b.PathByID(pathID); pd != nil && len(pd.Waypoints) > 0 {
+		wp := pd.Waypoints[0]
+		startPos = config.FPos{
+			X: float64(config.BoardOffsetX+wp.X*config.TileSize) + float64(config.TileSize)/2,
+			Y: float64(config.BoardOffsetY+wp.Y*config.TileSize) + float64(config.TileSize)/2,
 		}
 	}
 
 	hp := def.BaseHP * hpMul
-	visible := true
-	if def.Type == config.EnemyStalker {
-		visible = false
-	}
 
 	return &Enemy{
 		Def:         def,
@@ -58,35 +91,66 @@ func NewEnemy(def *data.EnemyDef, pathID string, hpMul, spdMul float64, b *board
 		WaypointIdx: 0,
 		Speed:       def.Speed * spdMul,
 		Alive:       true,
-		Visible:     visible,
+		Visible:     !def.Stealth,
+
+		DisruptTimer: def.DisruptCooldown,
+		DashTimer:    def.DashCooldown,
 	}
 }
 
+// NewSummon creates an enemy at the parent's current position on the same path
+func NewSummon(def *data.EnemyDef, parent *Enemy, hpMul, spdMul float64, b *board.Board) *Enemy {
+	e := NewEnemy(def, parent.PathID, hpMul, spdMul, b)
+	e.Pos = parent.Pos
+	e.WaypointIdx = parent.WaypointIdx
+	e.Traveled = parent.Traveled
+	return e
+}
+
 // Update moves the enemy along its path
 func (e *Enemy) Update(b *board.Board) {
 	if !e.Alive || e.Reached {
 		return
 	}
 
-	// Stun check
+	// Aura regeneration
+	if e.Buff.RegenPct > 0 {
+		e.HP = math.Min(e.MaxHP, e.HP+e.MaxHP*e.Buff.RegenPct/60.0)
+	}
+
+	if e.MarkTimer > 0 {
+		e.MarkTimer -= 1.0 / 60.0
+	}
+
+	// Boss timers
+	if e.ShieldTimer > 0 {
+		e.ShieldTimer -= 1.0 / 60.0
+		if e.ShieldTimer <= 0 {
+			e.Shield = 0
+		}
+	}
+	if e.BurstTimer > 0 {
+		e.BurstTimer -= 1.0 / 60.0
+	}
+
+	// Stun check (also breaks any telegraph or dash in progress)
 	if e.StunTimer > 0 {
 		e.StunTimer -= 1.0 / 60.0
+		e.InterruptDash()
 		return
 	}
 
 	// Find current path
-	var path *data.PathDef
-	for i := range b.PathDefs {
-		if b.PathDefs[i].ID == e.PathID {
-			path = &b.PathDefs[i]
-			break
-		}
-	}
+	path := b.PathByID(e.PathID)
 	if path == nil {
 		return
 	}
 
 	if e.WaypointIdx >= len(path.Waypoints)-1 {
+		if len(path.Branches) > 0 {
+			e.AtFork = true
+			return
+		}
 		e.Reached = true
 		return
 	}
@@ -100,17 +164,38 @@ func (e *Enemy) Update(b *board.Board) {
 	dy := targetY - e.Pos.Y
 	dist := math.Sqrt(dx*dx + dy*dy)
 
+	// Held by a summoned block
+	if e.Blocked {
+		e.InterruptDash()
+		return
+	}
+
+	// Dash telegraph: stand still while winding up
+	if e.updateDash(dx, dy, dist) {
+		return
+	}
+
 	// Apply slow
 	speed := e.Speed
 	if e.SlowTimer > 0 {
 		speed *= 0.6
 		e.SlowTimer -= 1.0 / 60.0
 	}
+	if e.DashLeft > 0 {
+		speed *= e.Def.DashSpeedMul
+	}
+	speed *= 1 + e.Buff.SpeedMul
+	speed *= 1 - e.Zone.Slow
+	if e.BurstTimer > 0 {
+		speed *= e.BurstMul
+	}
 
 	moveSpeed := speed * 60.0 // pixels per second at speed 1.0 = 60px/s
 	movePerFrame := moveSpeed / 60.0
 
+	moved := movePerFrame
 	if dist <= movePerFrame {
+		moved = dist
 		e.Pos.X = targetX
 		e.Pos.Y = targetY
 		e.WaypointIdx++
@@ -118,42 +203,244 @@ func (e *Enemy) Update(b *board.Board) {
 		e.Pos.X += (dx / dist) * movePerFrame
 		e.Pos.Y += (dy / dist) * movePerFrame
 	}
+
+	if e.DashLeft > 0 {
+		if dist > 0 {
+			e.DashDir = config.FPos{X: dx / dist, Y: dy / dist}
+		}
+		e.DashLeft -= moved
+		if e.DashLeft <= 0 {
+			e.DashLeft = 0
+			e.DashTimer = e.Def.DashCooldown
+		}
+	}
+}
+
+// updateDash advances the dash cycle and reports whether the enemy holds still this frame
+func (e *Enemy) updateDash(dx, dy, dist float64) bool {
+	if e.Def.DashDistance <= 0 || e.DashLeft > 0 {
+		return false
+	}
+	if e.WindupTimer > 0 {
+		e.WindupTimer -= 1.0 / 60.0
+		if e.WindupTimer <= 0 {
+			e.WindupTimer = 0
+			e.DashLeft = e.Def.DashDistance * float64(config.TileSize)
+		}
+		return true
+	}
+	e.DashTimer -= 1.0 / 60.0
+	if e.DashTimer > 0 {
+		return false
+	}
+	e.WindupTimer = e.Def.DashWindup
+	if dist > 0 {
+		e.DashDir = config.FPos{X: dx / dist, Y: dy / dist}
+	}
+	return true
+}
+
+// IsDashing reports whether the enemy is telegraphing or performing a dash
+func (e *Enemy) IsDashing() bool {
+	return e.WindupTimer > 0 || e.DashLeft > 0
+}
+
+// InterruptDash cancels a telegraph or dash in progress and restarts the cooldown
+func (e *Enemy) InterruptDash() {
+	if !e.IsDashing() {
+		return
+	}
+	e.WindupTimer = 0
+	e.DashLeft = 0
+	e.DashTimer = e.Def.DashCooldown
+}
+
+// Stun stops the enemy for the given duration, interrupting any dash
+func (e *Enemy) Stun(duration float64) {
+	e.StunTimer = math.Max(e.StunTimer, duration)
+	e.InterruptDash()
 }
 
-// TakeDamage applies damage to the enemy
-func (e *Enemy) TakeDamage(dmg float64, dmgType config.DamageType) {
+// ApplyOnHit applies an on-hit status effect from an attack
+func (e *Enemy) ApplyOnHit(eff data.OnHitEffect) {
 	if !e.Alive {
 		return
 	}
+	switch eff.Kind {
+	case config.StatusSlow:
+		e.SlowTimer = math.Max(e.SlowTimer, eff.Duration)
+	case config.StatusStun:
+		e.Stun(eff.Duration)
+	case config.StatusMark:
+		e.Mark(eff.Value, eff.Duration)
+	}
+}
+
+// Mark makes the enemy take extra damage for the given duration
+func (e *Enemy) Mark(mul, duration float64) {
+	e.MarkMul = math.Max(e.MarkMul, mul)
+	if e.MarkTimer <= 0 {
+		e.MarkMul = mul
+	}
+	e.MarkTimer = math.Max(e.MarkTimer, duration)
+}
+
+// Reveal forces a stealthed enemy visible for the given duration
+func (e *Enemy) Reveal(duration float64) {
+	e.RevealTimer = math.Max(e.RevealTimer, duration)
+}
+
+// UpdateVisibility ticks reveal timers and recomputes visibility for this frame
+func (e *Enemy) UpdateVisibility(detected bool) {
+	if e.RevealTimer > 0 {
+		e.RevealTimer -= 1.0 / 60.0
+	}
+	e.Visible = !e.Def.Stealth || detected || e.RevealTimer > 0
+}
+
+// pulseFxTime is how long the disruption ring stays on screen
+const pulseFxTime = 0.5
+
+// UpdateDisrupt ticks the disruption pulse timer and reports whether a pulse fires this frame
+func (e *Enemy) UpdateDisrupt() bool {
+	if e.PulseFx > 0 {
+		e.PulseFx -= 1.0 / 60.0
+	}
+	if e.Def.Disrupt == "" || !e.Alive || e.Reached || e.StunTimer > 0 {
+		return false
+	}
+	e.DisruptTimer -= 1.0 / 60.0
+	if e.DisruptTimer > 0 {
+		return false
+	}
+	e.DisruptTimer = e.Def.DisruptCooldown
+	e.PulseFx = pulseFxTime
+	return true
+}
+
+// EnterPhases advances the boss script and returns every phase whose HP
+// threshold has been crossed since the last call
+func (e *Enemy) EnterPhases() []data.BossPhaseDef {
+	if e.Def.Boss == nil || !e.Alive {
+		return nil
+	}
+	var entered []data.BossPhaseDef
+	phases := e.Def.Boss.Phases
+	for e.PhaseIdx < len(phases) && e.HP <= e.MaxHP*phases[e.PhaseIdx].HPPct {
+		entered = append(entered, phases[e.PhaseIdx])
+		e.PhaseIdx++
+	}
+	return entered
+}
+
+// AddShield grants a damage-absorbing shield; duration 0 lasts until broken
+func (e *Enemy) AddShield(amount, duration float64) {
+	e.Shield += amount
+	e.ShieldTimer = duration
+}
+
+// SpeedBurst multiplies movement speed for the given duration
+func (e *Enemy) SpeedBurst(mul, duration float64) {
+	e.BurstMul = mul
+	e.BurstTimer = duration
+}
+
+// IsAuraCarrier reports whether the enemy projects an aura
+func (e *Enemy) IsAuraCarrier() bool {
+	return e.Def.Aura != nil
+}
+
+// AuraCovers reports whether this carrier's aura reaches the other enemy
+func (e *Enemy) AuraCovers(other *Enemy) bool {
+	if e.Def.Aura == nil || other == e {
+		return false
+	}
+	radius := e.Def.Aura.Radius * float64(config.TileSize)
+	dx := other.Pos.X - e.Pos.X
+	dy := other.Pos.Y - e.Pos.Y
+	return dx*dx+dy*dy <= radius*radius
+}
+
+// ApplyAura merges an aura into the enemy's buff, keeping the strongest value of each bonus
+func (e *Enemy) ApplyAura(a *data.AuraDef) {
+	e.Buff.DmgReduce = math.Max(e.Buff.DmgReduce, a.DmgReduce)
+	e.Buff.SpeedMul = math.Max(e.Buff.SpeedMul, a.SpeedMul)
+	e.Buff.RegenPct = math.Max(e.Buff.RegenPct, a.RegenPct)
+}
+
+// DrawAura renders the aura radius of a carrier; drawn beneath all enemies
+func (e *Enemy) DrawAura(screen *ebiten.Image, tick int) {
+	if e.Def.Aura == nil || !e.Alive || e.Reached {
+		return
+	}
+	x := float32(e.Pos.X)
+	y := float32(e.Pos.Y)
+	r := float32(e.Def.Aura.Radius * float64(config.TileSize))
+	pulse := math.Sin(float64(tick%80)/80.0*math.Pi*2)*0.3 + 0.7
+	c := e.Def.Color
+	vector.DrawFilledCircle(screen, x, y, r, color.RGBA{c.R, c.G, c.B, uint8(18 * pulse)}, false)
+	vector.StrokeCircle(screen, x, y, r, 1, color.RGBA{c.R, c.G, c.B, uint8(90 * pulse)}, false)
+}
+
+// IsAir reports whether the enemy moves on the air layer
+func (e *Enemy) IsAir() bool {
+	return e.Def.Movement == config.MoveAir
+}
+
+// TakeDamage applies damage to the enemy and returns the damage that landed
+// (shield absorption included) and the overkill past its remaining HP
+func (e *Enemy) TakeDamage(dmg float64, dmgType config.DamageType) (dealt, overkill float64) {
+	if !e.Alive {
+		return 0, 0
+	}
 	actualDmg := dmg
 	// Shield enemies reduce ranged/phys damage
 	if e.Def.ShieldPct > 0 && dmgType == config.DamagePhys {
 		actualDmg *= (1.0 - e.Def.ShieldPct)
 	}
+	actualDmg *= 1 - e.Buff.DmgReduce
+	if e.MarkTimer > 0 {
+		actualDmg *= 1 + e.MarkMul
+	}
+	actualDmg *= 1 + e.Zone.Vulnerable
+	total := actualDmg
+	if e.Shield > 0 {
+		absorbed := math.Min(e.Shield, actualDmg)
+		e.Shield -= absorbed
+		actualDmg -= absorbed
+	}
+	if actualDmg > e.HP {
+		overkill = actualDmg - e.HP
+	}
 	e.HP -= actualDmg
 	if e.HP <= 0 {
 		e.HP = 0
 		e.Alive = false
 	}
+	return total - overkill, overkill
 }
 
-// GetProgress returns how far along the path this enemy is (0.0 to 1.0)
+// TakeBranch moves an enemy waiting at a fork onto the chosen branch path
+func (e *Enemy) TakeBranch(pathID string) {
+	e.Traveled += e.WaypointIdx
+	e.PathID = pathID
+	e.WaypointIdx = 0
+	e.AtFork = false
+}
+
+// GetProgress returns how far along its route this enemy is (0.0 to 1.0),
+// counting the shortest way on through any forks ahead
 func (e *Enemy) GetProgress(b *board.Board) float64 {
-	var path *data.PathDef
-	for i := range b.PathDefs {
-		if b.PathDefs[i].ID == e.PathID {
-			path = &b.PathDefs[i]
-			break
-		}
-	}
+	path := b.PathByID(e.PathID)
 	if path == nil {
 		return 0
 	}
-	totalWP := len(path.Waypoints)
-	if totalWP <= 1 {
+	done := e.Traveled + e.WaypointIdx
+	total := e.Traveled + len(path.Waypoints) - 1 + b.StepsAfter(e.PathID)
+	if total <= 0 {
 		return 0
 	}
-	return float64(e.WaypointIdx) / float64(totalWP-1)
+	return float64(done) / float64(total)
 }
 
 // Draw renders the enemy on screen
@@ -166,6 +453,26 @@ func (e *Enemy) Draw(screen *ebiten.Image, tick int) {
 	y := float32(e.Pos.Y)
 	r := float32(10)
 
+	// Dash telegraph line and dash trail
+	if e.WindupTimer > 0 {
+		dl := float32(e.Def.DashDistance * float64(config.TileSize))
+		alpha := uint8(90)
+		if tick%10 < 5 {
+			alpha = 200
+		}
+		tc := color.RGBA{e.Def.Color.R, e.Def.Color.G, e.Def.Color.B, alpha}
+		ex := x + float32(e.DashDir.X)*dl
+		ey := y + float32(e.DashDir.Y)*dl
+		vector.StrokeLine(screen, x, y, ex, ey, 2, tc, false)
+		vector.DrawFilledCircle(screen, ex, ey, 4, tc, false)
+	} else if e.DashLeft > 0 {
+		for i := 1; i <= 3; i++ {
+			tx := x - float32(e.DashDir.X)*float32(i*8)
+			ty := y - float32(e.DashDir.Y)*float32(i*8)
+			vector.DrawFilledCircle(screen, tx, ty, r*(1-float32(i)*0.2), config.WithAlpha(e.Def.Color, uint8(120-i*30)), false)
+		}
+	}
+
 	// Draw body based on enemy type
 	c := e.Def.Color
 	if !e.Visible {
@@ -187,6 +494,8 @@ func (e *Enemy) Draw(screen *ebiten.Image, tick int) {
 		// Shield indicator
 		vector.StrokeCircle(screen, x, y, r*0.6, 1.5, color.RGBA{200, 230, 255, 200}, false)
 	case config.EnemyFlyer:
+		// Ground shadow to show the air layer
+		vector.DrawFilledCircle(screen, x, y+r+6, r*0.7, color.RGBA{0, 0, 0, 90}, false)
 		// Diamond for flyer
 		var path vector.Path
 		path.MoveTo(x, y-r*1.3)
@@ -206,16 +515,25 @@ func (e *Enemy) Draw(screen *ebiten.Image, tick int) {
 		// Two small circles
 		vector.DrawFilledCircle(screen, x-4, y, r*0.8, c, false)
 		vector.DrawFilledCircle(screen, x+4, y, r*0.8, c, false)
+	case config.EnemyTotem:
+		// Pillar with a glowing core
+		vector.DrawFilledRect(screen, x-r*0.7, y-r*1.3, r*1.4, r*2.6, c, false)
+		vector.DrawFilledCircle(screen, x, y, r*0.45, brighten(c, 0.4), false)
 	default:
 		// Circle for basic
 		vector.DrawFilledCircle(screen, x, y, r, c, false)
-		if e.Def.Type != config.EnemyStalker || e.Visible {
+		if e.Visible {
 			vector.StrokeCircle(screen, x, y, r, 1, brighten(c, 0.3), false)
 		}
 	}
 
+	// Detected stealth marker
+	if e.Def.Stealth && e.Visible {
+		vector.StrokeCircle(screen, x, y, r+5, 1, color.RGBA{0, 255, 136, 140}, false)
+	}
+
 	// HP bar
-	if e.Visible || e.Def.Type != config.EnemyStalker {
+	if e.Visible {
 		barW := float32(24)
 		barH := float32(3)
 		barX := x - barW/2
@@ -232,8 +550,40 @@ func (e *Enemy) Draw(screen *ebiten.Image, tick int) {
 		vector.DrawFilledRect(screen, barX, barY, barW*ratio, barH, hpColor, false)
 	}
 
+	// Disruption pulse ring expanding to full radius
+	if e.PulseFx > 0 {
+		t := 1 - e.PulseFx/pulseFxTime
+		pr := float32(e.Def.DisruptRadius*float64(config.TileSize)*t) + r
+		alpha := uint8(200 * (1 - t))
+		vector.StrokeCircle(screen, x, y, pr, 2, color.RGBA{e.Def.Color.R, e.Def.Color.G, e.Def.Color.B, alpha}, false)
+	} else if e.Def.Disrupt != "" && e.DisruptTimer < 1.0 {
+		// Charging flicker before the next pulse
+		if tick%8 < 4 {
+			vector.StrokeCircle(screen, x, y, r+2, 1, e.Def.Color, false)
+		}
+	}
+
+	// Boss shield and speed burst
+	if e.Shield > 0 {
+		vector.StrokeCircle(screen, x, y, r*1.3+6, 2, color.RGBA{200, 230, 255, 200}, false)
+	}
+	if e.BurstTimer > 0 && tick%6 < 3 {
+		vector.StrokeCircle(screen, x, y, r*1.3+10, 1, color.RGBA{255, 160, 0, 200}, false)
+	}
+
+	// Mark sigil
+	if e.MarkTimer > 0 {
+		vector.StrokeLine(screen, x-4, y-r-14, x+4, y-r-14, 1.5, config.ColorNeonMagenta, false)
+		vector.StrokeLine(screen, x, y-r-18, x, y-r-10, 1.5, config.ColorNeonMagenta, false)
+	}
+
+	// Aura buff indicator
+	if e.Buff.Active() {
+		vector.DrawFilledCircle(screen, x+r, y-r, 2.5, color.RGBA{255, 255, 100, 220}, false)
+	}
+
 	// Slow indicator
-	if e.SlowTimer > 0 {
+	if e.SlowTimer > 0 || e.Zone.Slow > 0 {
 		vector.StrokeCircle(screen, x, y, r+3, 1, color.RGBA{0, 200, 255, 150}, false)
 	}
 }
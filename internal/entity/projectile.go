@@ -8,15 +8,211 @@ import (
 	"github.com/hajimehoshi/ebiten/v2/vector"
 
 	"neonsigil/internal/config"
+	"neonsigil/internal/data"
 )
 
+// defaultProjectileSpeed is used when a ProjectileDef leaves Speed at 0
+const defaultProjectileSpeed = 400.0
+
+// projectileFlashTime is how long impact visuals (beams, chains, blasts) linger
+const projectileFlashTime = 0.15
+
 // Projectile represents an in-flight projectile
 type Projectile struct {
 	X, Y     float64
 	TargetID int
-	Damage   float64
+	Damage   float64 // damage before the anti-air multiplier
+	AirMul   float64
+	HitsAir  bool
+	DmgType  config.DamageType
 	Speed    float64
 	Alive    bool
+	OnHit    []data.OnHitEffect
+	Def      *data.ProjectileDef // nil = single homing shot
+	Owner    *Unit               // credited with damage statistics
+	Aim      config.FPos         // last known target position
+	ZoneAt   *config.FPos        // impact point awaiting its ground zone (picked up by the battle)
+
+	// PIERCE: straight-line travel
+	DirX, DirY float64
+	TravelLeft float64
+	Hit        map[*Enemy]bool
+
+	// Impact visual: the projectile stays alive (without dealing damage)
+	// while the flash plays out
+	Flash      []config.FPos
+	FlashTimer float64
+}
+
+// NewProjectile creates a unit's attack aimed at target (enemies[targetID])
+func NewProjectile(u *Unit, target *Enemy, targetID int, onHits []data.OnHitEffect) *Projectile {
+	px := float64(config.BoardOffsetX+u.GridX*config.TileSize) + float64(config.TileSize)/2
+	py := float64(config.BoardOffsetY+u.GridY*config.TileSize) + float64(config.TileSize)/2
+
+	p := &Projectile{
+		X:        px,
+		Y:        py,
+		TargetID: targetID,
+		Damage:   u.EffectiveATK(),
+		AirMul:   u.EffectiveAirMul(),
+		HitsAir:  u.CanTargetAir(),
+		DmgType:  u.Def.DmgType,
+		Speed:    defaultProjectileSpeed,
+		Alive:    true,
+		OnHit:    onHits,
+		Def:      u.Def.Projectile,
+		Owner:    u,
+		Aim:      target.Pos,
+		Hit:      make(map[*Enemy]bool),
+	}
+	if p.Def != nil {
+		if p.Def.Speed > 0 {
+			p.Speed = p.Def.Speed
+		}
+		if p.Def.OnHit != nil {
+			p.OnHit = append([]data.OnHitEffect{*p.Def.OnHit}, onHits...)
+		}
+	}
+
+	if p.Kind() == config.ProjPierce {
+		dx := target.Pos.X - px
+		dy := target.Pos.Y - py
+		dist := math.Max(math.Sqrt(dx*dx+dy*dy), 1)
+		p.DirX, p.DirY = dx/dist, dy/dist
+		p.TravelLeft = float64(u.EffectiveRange()+1) * float64(config.TileSize)
+	}
+	return p
+}
+
+// Kind returns the projectile archetype
+func (p *Projectile) Kind() config.ProjectileKind {
+	if p.Def == nil {
+		return config.ProjSingle
+	}
+	return p.Def.Kind
+}
+
+// canHit reports whether the projectile can damage e
+func (p *Projectile) canHit(e *Enemy) bool {
+	return e.Alive && !e.Reached && (p.HitsAir || !e.IsAir())
+}
+
+// hitEnemy deals mul x damage to e and applies the on-hit effects
+func (p *Projectile) hitEnemy(e *Enemy, mul float64) {
+	dmg := p.Damage * mul
+	if e.IsAir() {
+		dmg *= p.AirMul
+	}
+	dealt, overkill := e.TakeDamage(dmg, p.DmgType)
+	p.Owner.Stats.Dealt += dealt
+	p.Owner.Stats.Overkill += overkill
+	for _, eff := range p.OnHit {
+		e.ApplyOnHit(eff)
+	}
+	p.Hit[e] = true
+}
+
+// Impact resolves the projectile reaching its target
+func (p *Projectile) Impact(target *Enemy, enemies []*Enemy) {
+	switch p.Kind() {
+	case config.ProjSplash:
+		p.explode(target.Pos.X, target.Pos.Y, enemies)
+	case config.ProjChain:
+		p.chain(target, enemies)
+	case config.ProjBeam:
+		p.Flash = []config.FPos{{X: p.X, Y: p.Y}, target.Pos}
+		p.hitEnemy(target, 1)
+	default:
+		p.hitEnemy(target, 1)
+		p.Alive = false
+		return
+	}
+	p.FlashTimer = projectileFlashTime
+}
+
+// explode damages everything in the splash radius, falling off to
+// Def.Falloff at the edge
+func (p *Projectile) explode(x, y float64, enemies []*Enemy) {
+	r := p.Def.Radius * float64(config.TileSize)
+	for _, e := range enemies {
+		if !p.canHit(e) {
+			continue
+		}
+		dx := e.Pos.X - x
+		dy := e.Pos.Y - y
+		dist := math.Sqrt(dx*dx + dy*dy)
+		if dist > r {
+			continue
+		}
+		p.hitEnemy(e, 1-(1-p.Def.Falloff)*dist/r)
+	}
+	if len(p.Hit) == 0 {
+		p.Owner.Stats.Wasted += p.Damage
+	}
+	p.X, p.Y = x, y
+	p.Flash = []config.FPos{{X: x, Y: y}}
+	if p.Def.Zone != "" {
+		p.ZoneAt = &config.FPos{X: x, Y: y}
+	}
+}
+
+// chain hits the target, then jumps to the nearest visible enemy not yet hit
+func (p *Projectile) chain(target *Enemy, enemies []*Enemy) {
+	p.Flash = []config.FPos{{X: p.X, Y: p.Y}, target.Pos}
+	p.hitEnemy(target, 1)
+
+	cur := target
+	mul := 1.0
+	jumpRange := p.Def.ChainRange * float64(config.TileSize)
+	for i := 0; i < p.Def.Chains; i++ {
+		var next *Enemy
+		bestDist := jumpRange
+		for _, e := range enemies {
+			if p.Hit[e] || !p.canHit(e) || !e.Visible {
+				continue
+			}
+			dx := e.Pos.X - cur.Pos.X
+			dy := e.Pos.Y - cur.Pos.Y
+			if d := math.Sqrt(dx*dx + dy*dy); d <= bestDist {
+				next, bestDist = e, d
+			}
+		}
+		if next == nil {
+			break
+		}
+		mul *= p.Def.ChainFalloff
+		p.hitEnemy(next, mul)
+		p.Flash = append(p.Flash, next.Pos)
+		cur = next
+	}
+}
+
+// updatePierce moves a piercing shot in a straight line, hitting every
+// enemy it passes through once
+func (p *Projectile) updatePierce(enemies []*Enemy) {
+	step := p.Speed / 60.0
+	p.X += p.DirX * step
+	p.Y += p.DirY * step
+	p.TravelLeft -= step
+
+	for _, e := range enemies {
+		if p.Hit[e] || !p.canHit(e) {
+			continue
+		}
+		dx := e.Pos.X - p.X
+		dy := e.Pos.Y - p.Y
+		if dx*dx+dy*dy > 12*12 {
+			continue
+		}
+		p.hitEnemy(e, 1)
+		if p.Def.Pierce > 0 && len(p.Hit) >= p.Def.Pierce {
+			p.Alive = false
+			return
+		}
+	}
+	if p.TravelLeft <= 0 {
+		p.Alive = false
+	}
 }
 
 // UpdateProjectiles updates all projectiles
@@ -26,24 +222,51 @@ func UpdateProjectiles(projectiles []*Projectile, enemies []*Enemy) {
 			continue
 		}
 
-		if p.TargetID < 0 || p.TargetID >= len(enemies) {
-			p.Alive = false
+		if p.FlashTimer > 0 {
+			p.FlashTimer -= 1.0 / 60.0
+			if p.FlashTimer <= 0 {
+				p.Alive = false
+			}
 			continue
 		}
 
-		target := enemies[p.TargetID]
-		if !target.Alive || target.Reached {
-			p.Alive = false
+		if p.Kind() == config.ProjPierce {
+			p.updatePierce(enemies)
 			continue
 		}
 
-		dx := target.Pos.X - p.X
-		dy := target.Pos.Y - p.Y
+		// TargetID -1 = flying on to the last known position
+		var target *Enemy
+		if p.TargetID >= 0 {
+			if p.TargetID >= len(enemies) {
+				p.Alive = false
+				continue
+			}
+			target = enemies[p.TargetID]
+			if !target.Alive || target.Reached {
+				target = p.onTargetLost(enemies)
+				if !p.Alive {
+					continue
+				}
+			}
+		}
+
+		aim := p.Aim
+		if target != nil {
+			aim = target.Pos
+			p.Aim = aim
+		}
+
+		dx := aim.X - p.X
+		dy := aim.Y - p.Y
 		dist := math.Sqrt(dx*dx + dy*dy)
 
 		if dist < 8 {
-			target.TakeDamage(p.Damage, config.DamagePhys)
-			p.Alive = false
+			if target != nil {
+				p.Impact(target, enemies)
+			} else {
+				p.impactGround(enemies)
+			}
 			continue
 		}
 
@@ -53,14 +276,107 @@ func UpdateProjectiles(projectiles []*Projectile, enemies []*Enemy) {
 	}
 }
 
+// onTargetLost applies the projectile's target-loss behavior. It returns the
+// new target when retargeting succeeds, or nil to fly on to the last known
+// position (IMPACT) or after fizzling (the projectile is then dead).
+func (p *Projectile) onTargetLost(enemies []*Enemy) *Enemy {
+	behavior := config.LossFizzle
+	if p.Def != nil && p.Def.OnLoss != "" {
+		behavior = p.Def.OnLoss
+	}
+	switch behavior {
+	case config.LossRetarget:
+		bestIdx := -1
+		bestDist := p.Def.RetargetRadius * float64(config.TileSize)
+		for i, e := range enemies {
+			if !p.canHit(e) || !e.Visible {
+				continue
+			}
+			dx := e.Pos.X - p.X
+			dy := e.Pos.Y - p.Y
+			if d := math.Sqrt(dx*dx + dy*dy); d <= bestDist {
+				bestIdx, bestDist = i, d
+			}
+		}
+		if bestIdx >= 0 {
+			p.TargetID = bestIdx
+			p.Owner.Stats.Retargeted++
+			return enemies[bestIdx]
+		}
+	case config.LossImpact:
+		p.TargetID = -1
+		return nil
+	}
+
+	p.Owner.Stats.Fizzled++
+	p.Owner.Stats.Wasted += p.Damage
+	p.Alive = false
+	return nil
+}
+
+// impactGround resolves a shot that reached the last known position of a
+// lost target: splash shots still explode, anything else is wasted
+func (p *Projectile) impactGround(enemies []*Enemy) {
+	if p.Kind() == config.ProjSplash {
+		p.explode(p.Aim.X, p.Aim.Y, enemies)
+		p.FlashTimer = projectileFlashTime
+		return
+	}
+	p.Owner.Stats.Wasted += p.Damage
+	p.Alive = false
+}
+
 // DrawProjectiles draws all projectiles
 func DrawProjectiles(screen *ebiten.Image, projectiles []*Projectile) {
 	for _, p := range projectiles {
 		if !p.Alive {
 			continue
 		}
-		vector.DrawFilledCircle(screen, float32(p.X), float32(p.Y), 3, config.ColorNeonCyan, false)
+		clr := config.ColorNeonCyan
+		if p.Def != nil && p.Def.Color.A > 0 {
+			clr = p.Def.Color
+		}
+
+		if p.FlashTimer > 0 {
+			p.drawFlash(screen, clr)
+			continue
+		}
+
+		if p.Kind() == config.ProjPierce {
+			tx := float32(p.X - p.DirX*10)
+			ty := float32(p.Y - p.DirY*10)
+			vector.StrokeLine(screen, tx, ty, float32(p.X), float32(p.Y), 2, clr, false)
+			continue
+		}
+
+		vector.DrawFilledCircle(screen, float32(p.X), float32(p.Y), 3, clr, false)
 		// Trail
-		vector.DrawFilledCircle(screen, float32(p.X-2), float32(p.Y-1), 2, color.RGBA{0, 200, 255, 100}, false)
+		vector.DrawFilledCircle(screen, float32(p.X-2), float32(p.Y-1), 2, color.RGBA{clr.R, clr.G, clr.B, 100}, false)
+	}
+}
+
+// drawFlash draws the fading impact visual
+func (p *Projectile) drawFlash(screen *ebiten.Image, clr color.RGBA) {
+	alpha := uint8(255 * p.FlashTimer / projectileFlashTime)
+	fc := config.WithAlpha(clr, alpha)
+	switch p.Kind() {
+	case config.ProjSplash:
+		r := float32(p.Def.Radius * float64(config.TileSize))
+		grow := float32(1 - p.FlashTimer/projectileFlashTime*0.5)
+		vector.DrawFilledCircle(screen, float32(p.X), float32(p.Y), r*grow, config.WithAlpha(clr, alpha/4), false)
+		vector.StrokeCircle(screen, float32(p.X), float32(p.Y), r*grow, 2, fc, false)
+	case config.ProjBeam:
+		a, b := p.Flash[0], p.Flash[1]
+		vector.StrokeLine(screen, float32(a.X), float32(a.Y), float32(b.X), float32(b.Y), 4, config.WithAlpha(clr, alpha/3), false)
+		vector.StrokeLine(screen, float32(a.X), float32(a.Y), float32(b.X), float32(b.Y), 1.5, fc, false)
+	case config.ProjChain:
+		for i := 1; i < len(p.Flash); i++ {
+			a, b := p.Flash[i-1], p.Flash[i]
+			// Jagged midpoint for a lightning look
+			mx := float32((a.X+b.X)/2 + (b.Y-a.Y)*0.15)
+			my := float32((a.Y+b.Y)/2 - (b.X-a.X)*0.15)
+			vector.StrokeLine(screen, float32(a.X), float32(a.Y), mx, my, 1.5, fc, false)
+			vector.StrokeLine(screen, mx, my, float32(b.X), float32(b.Y), 1.5, fc, false)
+		}
 	}
 }
@@ -0,0 +1,20 @@
+package entity
+
+// CombatStats tracks a unit's damage output, including damage that did
+// nothing useful
+type CombatStats struct {
+	Dealt      float64 // damage that removed HP or shield
+	Overkill   float64 // damage past the killing blow
+	Wasted     float64 // damage of shots that fizzled or hit nothing
+	Fizzled    int     // shots lost with their target
+	Retargeted int     // shots that found a new target mid-flight
+}
+
+// Add accumulates other into s
+func (s *CombatStats) Add(other CombatStats) {
+	s.Dealt += other.Dealt
+	s.Overkill += other.Overkill
+	s.Wasted += other.Wasted
+	s.Fizzled += other.Fizzled
+	s.Retargeted += other.Retargeted
+}
@@ -0,0 +1,201 @@
+package entity
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"neonsigil/internal/config"
+	"neonsigil/internal/data"
+)
+
+// Summon is a temporary entity created by a unit's skill
+type Summon struct {
+	Def         *data.SummonDef
+	Owner       *Unit
+	Pos         config.FPos // pixel position
+	Tile        config.Pos  // BLOCK/TURRET: occupied tile
+	HP          float64     // BLOCK: remaining hold time
+	Timer       float64     // remaining lifetime (unused when Def.Duration is 0)
+	AtkCooldown float64
+	Angle       float64 // DRONE: orbit angle
+	Alive       bool
+}
+
+// NewUnitSummon creates a summon owned by u on the given tile. Drones start on
+// their orbit around the owner instead.
+func NewUnitSummon(u *Unit, tile config.Pos) *Summon {
+	s := &Summon{
+		Def:   u.Def.Summon,
+		Owner: u,
+		Tile:  tile,
+		HP:    u.Def.Summon.HP,
+		Timer: u.Def.Summon.Duration,
+		Alive: true,
+	}
+	s.Pos = tileCenter(tile)
+	return s
+}
+
+// tileCenter returns the pixel center of a grid tile
+func tileCenter(p config.Pos) config.FPos {
+	return config.FPos{
+		X: float64(config.BoardOffsetX+p.X*config.TileSize) + float64(config.TileSize)/2,
+		Y: float64(config.BoardOffsetY+p.Y*config.TileSize) + float64(config.TileSize)/2,
+	}
+}
+
+// OnTile reports whether the summon occupies the given grid tile
+func (s *Summon) OnTile(gx, gy int) bool {
+	return s.Alive && s.Def.Kind != config.SummonDrone && s.Tile.X == gx && s.Tile.Y == gy
+}
+
+// Holds reports whether a block summon stops the given enemy
+func (s *Summon) Holds(e *Enemy) bool {
+	if !s.Alive || s.Def.Kind != config.SummonBlock || !e.Alive || e.Reached || e.IsAir() {
+		return false
+	}
+	dx := e.Pos.X - s.Pos.X
+	dy := e.Pos.Y - s.Pos.Y
+	r := float64(config.TileSize) * 0.5
+	return dx*dx+dy*dy <= r*r
+}
+
+// Update runs one frame of the summon: lifetime, orbit, blocking and attacks
+func (s *Summon) Update(enemies []*Enemy, projectiles *[]*Projectile) {
+	if !s.Alive {
+		return
+	}
+	if !s.Owner.Deployed {
+		s.Alive = false
+		return
+	}
+	if s.Def.Duration > 0 {
+		s.Timer -= 1.0 / 60.0
+		if s.Timer <= 0 {
+			s.Alive = false
+			return
+		}
+	}
+
+	switch s.Def.Kind {
+	case config.SummonDrone:
+		s.Angle += 1.5 / 60.0
+		c := tileCenter(config.Pos{X: s.Owner.GridX, Y: s.Owner.GridY})
+		r := s.Def.Orbit * float64(config.TileSize)
+		s.Pos = config.FPos{X: c.X + math.Cos(s.Angle)*r, Y: c.Y + math.Sin(s.Angle)*r}
+	case config.SummonBlock:
+		for _, e := range enemies {
+			if s.Holds(e) {
+				e.Blocked = true
+				s.HP -= float64(e.Def.LeakDamage) / 60.0
+			}
+		}
+		if s.HP <= 0 {
+			s.Alive = false
+		}
+		return
+	}
+
+	s.AtkCooldown -= 1.0 / 60.0
+	if s.AtkCooldown > 0 {
+		return
+	}
+	idx := s.findTarget(enemies)
+	if idx < 0 {
+		return
+	}
+	s.AtkCooldown = 1.0 / s.Def.AtkSpeed
+	*projectiles = append(*projectiles, s.shoot(enemies[idx], idx))
+}
+
+// findTarget returns the index of the nearest visible enemy in range, or -1
+func (s *Summon) findTarget(enemies []*Enemy) int {
+	best := -1
+	bestDist := s.Def.Range*float64(config.TileSize) + float64(config.TileSize)/2
+	for i, e := range enemies {
+		if !e.Alive || e.Reached || !e.Visible || (e.IsAir() && !s.Owner.CanTargetAir()) {
+			continue
+		}
+		dx := e.Pos.X - s.Pos.X
+		dy := e.Pos.Y - s.Pos.Y
+		if d := math.Sqrt(dx*dx + dy*dy); d <= bestDist {
+			best, bestDist = i, d
+		}
+	}
+	return best
+}
+
+// shoot fires a single homing shot credited to the owner
+func (s *Summon) shoot(target *Enemy, targetID int) *Projectile {
+	return &Projectile{
+		X:        s.Pos.X,
+		Y:        s.Pos.Y,
+		TargetID: targetID,
+		Damage:   s.Owner.EffectiveATK() * s.Def.ATKMul,
+		AirMul:   s.Owner.EffectiveAirMul(),
+		HitsAir:  s.Owner.CanTargetAir(),
+		DmgType:  s.Owner.Def.DmgType,
+		Speed:    defaultProjectileSpeed,
+		Alive:    true,
+		Owner:    s.Owner,
+		Aim:      target.Pos,
+		Hit:      make(map[*Enemy]bool),
+	}
+}
+
+// Covers reports whether a turret's cooldown aura reaches the given unit
+func (s *Summon) Covers(u *Unit) bool {
+	if !s.Alive || s.Def.CDR <= 0 || !u.Deployed {
+		return false
+	}
+	c := tileCenter(config.Pos{X: u.GridX, Y: u.GridY})
+	dx := c.X - s.Pos.X
+	dy := c.Y - s.Pos.Y
+	r := (s.Def.Range + 0.5) * float64(config.TileSize)
+	return dx*dx+dy*dy <= r*r
+}
+
+// Draw renders the summon in its owner's faction color
+func (s *Summon) Draw(screen *ebiten.Image, tick int) {
+	if !s.Alive {
+		return
+	}
+	x := float32(s.Pos.X)
+	y := float32(s.Pos.Y)
+	fc := config.FactionColors[s.Owner.Def.Faction]
+
+	// Flicker during the last second
+	if s.Def.Duration > 0 && s.Timer < 1 && tick%8 < 4 {
+		fc = config.WithAlpha(fc, 100)
+	}
+
+	switch s.Def.Kind {
+	case config.SummonDrone:
+		vector.DrawFilledCircle(screen, x, y, 5, color.RGBA{fc.R / 3, fc.G / 3, fc.B / 3, 240}, false)
+		vector.StrokeCircle(screen, x, y, 5, 1.5, fc, false)
+		vector.StrokeLine(screen, x-8, y, x+8, y, 1, fc, false)
+	case config.SummonBlock:
+		h := float32(config.TileSize)/2 - 10
+		vector.DrawFilledRect(screen, x-h, y-h, h*2, h*2, color.RGBA{fc.R / 4, fc.G / 4, fc.B / 4, 220}, false)
+		vector.StrokeRect(screen, x-h, y-h, h*2, h*2, 2, fc, false)
+		vector.StrokeLine(screen, x-h, y-h, x+h, y+h, 1, fc, false)
+		vector.StrokeLine(screen, x+h, y-h, x-h, y+h, 1, fc, false)
+		// Hold bar
+		ratio := float32(math.Max(s.HP/s.Def.HP, 0))
+		vector.DrawFilledRect(screen, x-h, y+h+3, h*2, 3, color.RGBA{40, 40, 40, 200}, false)
+		vector.DrawFilledRect(screen, x-h, y+h+3, h*2*ratio, 3, fc, false)
+	case config.SummonTurret:
+		h := float32(config.TileSize)/2 - 14
+		vector.DrawFilledRect(screen, x-h, y-h, h*2, h*2, color.RGBA{fc.R / 3, fc.G / 3, fc.B / 3, 220}, false)
+		vector.StrokeRect(screen, x-h, y-h, h*2, h*2, 1.5, fc, false)
+		vector.DrawFilledCircle(screen, x, y, 4, fc, false)
+		if s.Def.CDR > 0 {
+			pulse := math.Sin(float64(tick%90)/90.0*math.Pi*2)*0.3 + 0.7
+			r := float32((s.Def.Range + 0.5) * float64(config.TileSize))
+			vector.StrokeCircle(screen, x, y, r, 1, color.RGBA{fc.R, fc.G, fc.B, uint8(50 * pulse)}, false)
+		}
+	}
+}
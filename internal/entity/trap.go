@@ -0,0 +1,167 @@
+package entity
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"neonsigil/internal/config"
+	"neonsigil/internal/data"
+)
+
+// trapFlashTime is how long the trigger visual lingers
+const trapFlashTime = 0.2
+
+// Trap sits on a path tile and triggers on ground enemies stepping on it
+type Trap struct {
+	Def        *data.TrapDef
+	Tile       config.Pos
+	Pos        config.FPos // pixel center
+	Charges    int
+	RearmTimer float64
+	Owner      *Unit // unit that laid it with a skill (nil = bought)
+	FlashTimer float64
+	Alive      bool
+}
+
+// NewTrap creates a trap on the given path tile
+func NewTrap(def *data.TrapDef, tile config.Pos, owner *Unit) *Trap {
+	return &Trap{
+		Def:     def,
+		Tile:    tile,
+		Pos:     tileCenter(tile),
+		Charges: max(def.Charges, 1),
+		Owner:   owner,
+		Alive:   true,
+	}
+}
+
+// Armed reports whether the trap is ready to trigger
+func (t *Trap) Armed() bool {
+	return t.Alive && t.RearmTimer <= 0
+}
+
+// steppedOn reports whether a ground enemy is standing on the trap
+func (t *Trap) steppedOn(e *Enemy) bool {
+	if !e.Alive || e.Reached || e.IsAir() {
+		return false
+	}
+	dx := e.Pos.X - t.Pos.X
+	dy := e.Pos.Y - t.Pos.Y
+	r := float64(config.TileSize) * 0.4
+	return dx*dx+dy*dy <= r*r
+}
+
+// Update rearms the trap and triggers it when an enemy steps on it
+func (t *Trap) Update(enemies []*Enemy) {
+	if t.FlashTimer > 0 {
+		t.FlashTimer -= 1.0 / 60.0
+	}
+	if !t.Alive {
+		return
+	}
+	if t.RearmTimer > 0 {
+		t.RearmTimer -= 1.0 / 60.0
+		return
+	}
+
+	var victims []*Enemy
+	for _, e := range enemies {
+		if t.steppedOn(e) {
+			victims = append(victims, e)
+		}
+	}
+	if len(victims) == 0 {
+		return
+	}
+
+	switch t.Def.Kind {
+	case config.TrapSpike:
+		for _, e := range victims {
+			t.damage(e, t.Def.Damage)
+		}
+	case config.TrapSnare:
+		victims[0].Stun(t.Def.Stun)
+	case config.TrapMine:
+		r := t.Def.Radius * float64(config.TileSize)
+		for _, e := range enemies {
+			if !e.Alive || e.Reached || e.IsAir() {
+				continue
+			}
+			dx := e.Pos.X - t.Pos.X
+			dy := e.Pos.Y - t.Pos.Y
+			if dx*dx+dy*dy <= r*r {
+				t.damage(e, t.Def.Damage)
+			}
+		}
+	}
+
+	t.FlashTimer = trapFlashTime
+	t.Charges--
+	if t.Charges <= 0 {
+		t.Alive = false
+		return
+	}
+	t.RearmTimer = t.Def.Rearm
+}
+
+// damage hurts an enemy, crediting the owner for skill traps
+func (t *Trap) damage(e *Enemy, dmg float64) {
+	dealt, overkill := e.TakeDamage(dmg, config.DamagePhys)
+	if t.Owner != nil {
+		t.Owner.Stats.Dealt += dealt
+		t.Owner.Stats.Overkill += overkill
+	}
+}
+
+// Draw renders the trap on its tile; drawn beneath all enemies
+func (t *Trap) Draw(screen *ebiten.Image, tick int) {
+	if !t.Alive && t.FlashTimer <= 0 {
+		return
+	}
+	x := float32(t.Pos.X)
+	y := float32(t.Pos.Y)
+	c := t.Def.Color
+	if !t.Armed() {
+		c = config.WithAlpha(c, 90)
+	}
+
+	switch t.Def.Kind {
+	case config.TrapSpike:
+		for i := -1; i <= 1; i++ {
+			sx := x + float32(i)*8
+			vector.StrokeLine(screen, sx-4, y+5, sx, y-5, 1.5, c, false)
+			vector.StrokeLine(screen, sx, y-5, sx+4, y+5, 1.5, c, false)
+		}
+	case config.TrapSnare:
+		vector.StrokeCircle(screen, x, y, 10, 1.5, c, false)
+		for i := 0; i < 6; i++ {
+			a := float64(i) * math.Pi / 3
+			vector.StrokeLine(screen, x+float32(math.Cos(a))*6, y+float32(math.Sin(a))*6,
+				x+float32(math.Cos(a))*12, y+float32(math.Sin(a))*12, 1, c, false)
+		}
+	case config.TrapMine:
+		vector.DrawFilledCircle(screen, x, y, 6, color.RGBA{c.R / 3, c.G / 3, c.B / 3, 220}, false)
+		vector.StrokeCircle(screen, x, y, 6, 1.5, c, false)
+		if t.Armed() && tick%40 < 20 {
+			vector.DrawFilledCircle(screen, x, y, 2, c, false)
+		}
+	}
+
+	// Remaining charges
+	for i := 0; i < t.Charges && t.Alive; i++ {
+		vector.DrawFilledRect(screen, x-14+float32(i)*5, y+16, 3, 3, c, false)
+	}
+
+	// Trigger flash
+	if t.FlashTimer > 0 {
+		r := float32(config.TileSize) * 0.4
+		if t.Def.Kind == config.TrapMine {
+			r = float32(t.Def.Radius * float64(config.TileSize))
+		}
+		alpha := uint8(200 * t.FlashTimer / trapFlashTime)
+		vector.StrokeCircle(screen, x, y, r, 2, color.RGBA{t.Def.Color.R, t.Def.Color.G, t.Def.Color.B, alpha}, false)
+	}
+}
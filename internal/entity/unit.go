@@ -25,6 +25,23 @@ type Unit struct {
 	Range       int
 	AtkCooldown float64
 	Deployed    bool
+
+	Tile *data.SpecialDef // special tile under the unit, refreshed every tick
+
+	Targeting config.TargetMode // player-selected priority, defaults to Def.Targeting
+	Target    *Enemy            // last enemy attacked (kept by STICKY targeting)
+	Stats     CombatStats
+
+	Items    []*data.ItemDef // equipped items (max config.ItemSlots)
+	HitCount int             // attacks made, drives every-Nth on-hit effects
+
+	SummonTimer float64 // time until the next summon (Def.Summon)
+	TrapTimer   float64 // time until the next trap (Def.TrapSkill)
+
+	// Disruption status (HACKER pulses)
+	SilenceTimer float64
+	AtkSlowTimer float64
+	JamTimer     float64
 }
 
 // NewUnit creates a new unit from a definition
@@ -41,9 +58,19 @@ func NewUnit(def *data.UnitDef) *Unit {
 		AtkSpeed:  def.AtkSpeed,
 		Range:     def.Range,
 		Deployed:  false,
+		Targeting: def.Targeting,
 	}
 }
 
+// StarUp raises the star level and rescales HP and ATK from the definition
+func (u *Unit) StarUp() {
+	u.Star++
+	hpMul, atkMul := data.StarMultipliers(u.Def)
+	u.MaxHP = u.Def.HP * math.Pow(hpMul, float64(u.Star-1))
+	u.HP = u.MaxHP
+	u.ATK = u.Def.ATK * math.Pow(atkMul, float64(u.Star-1))
+}
+
 // Place deploys the unit to the board at the given grid position
 func (u *Unit) Place(gx, gy int) {
 	u.GridX = gx
@@ -60,6 +87,126 @@ func (u *Unit) PlaceBench(slot int) {
 	u.Deployed = false
 }
 
+// CanEquip reports whether the unit has a free item slot
+func (u *Unit) CanEquip() bool {
+	return len(u.Items) < config.ItemSlots
+}
+
+// Equip adds an item to a free slot
+func (u *Unit) Equip(it *data.ItemDef) bool {
+	if !u.CanEquip() {
+		return false
+	}
+	u.Items = append(u.Items, it)
+	return true
+}
+
+// EffectiveATK returns the attack including item bonuses
+func (u *Unit) EffectiveATK() float64 {
+	pct := 0.0
+	for _, it := range u.Items {
+		pct += it.ATKPct
+	}
+	return u.ATK * (1 + pct)
+}
+
+// EffectiveRange returns the attack range including tile and item bonuses
+func (u *Unit) EffectiveRange() int {
+	r := u.Range
+	if u.Tile != nil {
+		r += u.Tile.RangeBonus
+	}
+	for _, it := range u.Items {
+		r += it.RangeBonus
+	}
+	return r
+}
+
+// EffectiveAtkSpeed returns the attack speed including item bonuses
+func (u *Unit) EffectiveAtkSpeed() float64 {
+	pct := 0.0
+	for _, it := range u.Items {
+		pct += it.AtkSpeedPct
+	}
+	return u.AtkSpeed * (1 + pct)
+}
+
+// EffectiveAirMul returns the anti-air damage multiplier including item bonuses
+func (u *Unit) EffectiveAirMul() float64 {
+	m := u.Def.AirMul
+	for _, it := range u.Items {
+		m += it.AirMulBonus
+	}
+	return m
+}
+
+// DisruptResist returns the item disruption resistance (0..1)
+func (u *Unit) DisruptResist() float64 {
+	res := 0.0
+	for _, it := range u.Items {
+		res += it.DisruptRes
+	}
+	return math.Min(res, 1)
+}
+
+// nextOnHits counts an attack and returns the item effects it triggers
+func (u *Unit) nextOnHits() []data.OnHitEffect {
+	u.HitCount++
+	var effects []data.OnHitEffect
+	for _, it := range u.Items {
+		if it.OnHit == nil {
+			continue
+		}
+		if it.OnHit.Every <= 1 || u.HitCount%it.OnHit.Every == 0 {
+			effects = append(effects, *it.OnHit)
+		}
+	}
+	return effects
+}
+
+// Disrupt applies a disruption effect for the given duration
+func (u *Unit) Disrupt(kind config.DisruptType, duration float64) {
+	switch kind {
+	case config.DisruptSilence:
+		u.SilenceTimer = math.Max(u.SilenceTimer, duration)
+	case config.DisruptSlow:
+		u.AtkSlowTimer = math.Max(u.AtkSlowTimer, duration)
+	case config.DisruptJam:
+		u.JamTimer = math.Max(u.JamTimer, duration)
+	}
+}
+
+// IsDisrupted reports whether any disruption effect is active on the unit
+func (u *Unit) IsDisrupted() bool {
+	return u.SilenceTimer > 0 || u.AtkSlowTimer > 0 || u.JamTimer > 0
+}
+
+// CanTargetAir reports whether the unit can attack air enemies
+func (u *Unit) CanTargetAir() bool {
+	return u.Def.AtkType != config.AttackMelee && u.Def.AirMul > 0
+}
+
+// DamageAgainst returns the unit's attack damage against the given enemy
+func (u *Unit) DamageAgainst(e *Enemy) float64 {
+	if e.IsAir() {
+		return u.EffectiveATK() * u.EffectiveAirMul()
+	}
+	return u.EffectiveATK()
+}
+
+// Detects reports whether the unit's detection radius covers the given pixel position
+func (u *Unit) Detects(pos config.FPos) bool {
+	if !u.Deployed || u.Def.DetectRange <= 0 {
+		return false
+	}
+	unitPx := float64(config.BoardOffsetX+u.GridX*config.TileSize) + float64(config.TileSize)/2
+	unitPy := float64(config.BoardOffsetY+u.GridY*config.TileSize) + float64(config.TileSize)/2
+	dx := pos.X - unitPx
+	dy := pos.Y - unitPy
+	radius := float64(u.Def.DetectRange)*float64(config.TileSize) + float64(config.TileSize)/2
+	return dx*dx+dy*dy <= radius*radius
+}
+
 // FindTarget finds the best target enemy based on the unit's targeting mode
 func (u *Unit) FindTarget(enemies []*Enemy, b *board.Board) *Enemy {
 	if !u.Deployed {
@@ -68,35 +215,69 @@ func (u *Unit) FindTarget(enemies []*Enemy, b *board.Board) *Enemy {
 
 	unitPx := float64(config.BoardOffsetX+u.GridX*config.TileSize) + float64(config.TileSize)/2
 	unitPy := float64(config.BoardOffsetY+u.GridY*config.TileSize) + float64(config.TileSize)/2
-	rangePixels := float64(u.Range) * float64(config.TileSize)
-
-	var best *Enemy
-	var bestScore float64
+	rangePixels := float64(u.EffectiveRange()) * float64(config.TileSize)
 
-	for _, e := range enemies {
-		if !e.Alive || e.Reached {
-			continue
+	// inRange reports whether the unit can attack e, and how far away it is
+	inRange := func(e *Enemy) (float64, bool) {
+		if !e.Alive || e.Reached || !e.Visible {
+			return 0, false
 		}
-		if !e.Visible && e.Def.Type == config.EnemyStalker {
-			continue
+		if e.IsAir() && !u.CanTargetAir() {
+			return 0, false
 		}
-
 		dx := e.Pos.X - unitPx
 		dy := e.Pos.Y - unitPy
 		dist := math.Sqrt(dx*dx + dy*dy)
+		return dist, dist <= rangePixels+float64(config.TileSize)/2
+	}
 
-		if dist > rangePixels+float64(config.TileSize)/2 {
+	// Sticky units keep their current target while it stays in range
+	if u.Targeting == config.TargetSticky && u.JamTimer <= 0 && u.Target != nil {
+		if _, ok := inRange(u.Target); ok {
+			return u.Target
+		}
+	}
+
+	var best *Enemy
+	var bestScore float64
+
+	for _, e := range enemies {
+		dist, ok := inRange(e)
+		if !ok {
 			continue
 		}
 
 		var score float64
-		switch u.Def.Targeting {
-		case config.TargetFrontmost:
+		switch u.Targeting {
+		case config.TargetFrontmost, config.TargetSticky:
 			score = e.GetProgress(b)
+		case config.TargetLast:
+			score = -e.GetProgress(b)
+		case config.TargetStrongest:
+			score = e.HP
+		case config.TargetMaxHP:
+			score = e.MaxHP
 		case config.TargetLowHP:
 			score = 1.0 - (e.HP / e.MaxHP)
 		case config.TargetNearest:
 			score = 1.0 - (dist / rangePixels)
+		case config.TargetAuraFirst:
+			// Aura carriers always outrank others, frontmost within each group
+			score = e.GetProgress(b)
+			if e.IsAuraCarrier() {
+				score += 1.0
+			}
+		case config.TargetAirFirst:
+			// Air enemies always outrank ground ones, frontmost within each layer
+			score = e.GetProgress(b)
+			if e.IsAir() {
+				score += 1.0
+			}
+		}
+
+		// Jammed units pick the worst candidate for their mode
+		if u.JamTimer > 0 {
+			score = -score
 		}
 
 		if best == nil || score > bestScore {
@@ -105,16 +286,46 @@ func (u *Unit) FindTarget(enemies []*Enemy, b *board.Board) *Enemy {
 		}
 	}
 
+	u.Target = best
 	return best
 }
 
+// CycleTargeting switches to the next targeting priority
+func (u *Unit) CycleTargeting() {
+	for i, m := range config.TargetModes {
+		if m == u.Targeting {
+			u.Targeting = config.TargetModes[(i+1)%len(config.TargetModes)]
+			return
+		}
+	}
+	u.Targeting = config.TargetModes[0]
+}
+
 // Update runs the unit's combat logic
 func (u *Unit) Update(enemies []*Enemy, b *board.Board, projectiles *[]*Projectile) {
 	if !u.Deployed {
 		return
 	}
 
-	u.AtkCooldown -= 1.0 / 60.0
+	// Tick every disruption before a silence skips the rest of the frame
+	slowed := u.AtkSlowTimer > 0
+	silenced := u.SilenceTimer > 0
+	if u.JamTimer > 0 {
+		u.JamTimer -= 1.0 / 60.0
+	}
+	if slowed {
+		u.AtkSlowTimer -= 1.0 / 60.0
+	}
+	if silenced {
+		u.SilenceTimer -= 1.0 / 60.0
+		return
+	}
+
+	if slowed {
+		u.AtkCooldown -= 0.5 / 60.0
+	} else {
+		u.AtkCooldown -= 1.0 / 60.0
+	}
 	if u.AtkCooldown > 0 {
 		return
 	}
@@ -124,25 +335,24 @@ func (u *Unit) Update(enemies []*Enemy, b *board.Board, projectiles *[]*Projecti
 		return
 	}
 
-	u.AtkCooldown = 1.0 / u.AtkSpeed
-
-	unitPx := float64(config.BoardOffsetX+u.GridX*config.TileSize) + float64(config.TileSize)/2
-	unitPy := float64(config.BoardOffsetY+u.GridY*config.TileSize) + float64(config.TileSize)/2
+	u.AtkCooldown = 1.0 / u.EffectiveAtkSpeed()
 
+	onHits := u.nextOnHits()
 	if u.Def.AtkType == config.AttackMelee {
 		// Instant damage
-		target.TakeDamage(u.ATK, u.Def.DmgType)
+		dealt, overkill := target.TakeDamage(u.DamageAgainst(target), u.Def.DmgType)
+		u.Stats.Dealt += dealt
+		u.Stats.Overkill += overkill
+		for _, eff := range onHits {
+			target.ApplyOnHit(eff)
+		}
 	} else {
-		// Spawn projectile
+		// Spawn projectile; beams hit instantly
 		for i, e := range enemies {
 			if e == target {
-				proj := &Projectile{
-					X:        unitPx,
-					Y:        unitPy,
-					TargetID: i,
-					Damage:   u.ATK,
-					Speed:    400.0,
-					Alive:    true,
+				proj := NewProjectile(u, target, i, onHits)
+				if proj.Kind() == config.ProjBeam {
+					proj.Impact(target, enemies)
 				}
 				*projectiles = append(*projectiles, proj)
 				break
@@ -199,10 +409,27 @@ func (u *Unit) Draw(screen *ebiten.Image, tick int) {
 		vector.DrawFilledCircle(screen, starX, starY, 3, config.ColorNeonYellow, false)
 	}
 
+	// Disruption static overlay
+	if u.IsDisrupted() {
+		dc := color.RGBA{0, 255, 200, 160}
+		for i := 0; i < 3; i++ {
+			ly := sy - s + float32((tick/3+i*7)%int(s*2))
+			vector.DrawFilledRect(screen, sx-s, ly, s*2, 1, dc, false)
+		}
+		if u.SilenceTimer > 0 {
+			vector.StrokeLine(screen, sx-s, sy-s, sx+s, sy+s, 2, dc, false)
+		}
+	}
+
+	// Equipped item pips (top-right corner)
+	for i, it := range u.Items {
+		vector.DrawFilledRect(screen, sx+s-6, sy-s+2+float32(i)*6, 4, 4, it.Color, false)
+	}
+
 	// Attack cooldown indicator (small bar at bottom)
 	if u.AtkCooldown > 0 {
 		barW := s * 2
-		ratio := float32(u.AtkCooldown / (1.0 / u.AtkSpeed))
+		ratio := float32(u.AtkCooldown * u.EffectiveAtkSpeed())
 		if ratio > 1 {
 			ratio = 1
 		}
@@ -233,4 +460,9 @@ func (u *Unit) DrawOnBench(screen *ebiten.Image, slot int, tick int) {
 		starX := cx - float32(u.Star-1)*4 + float32(i)*8
 		vector.DrawFilledCircle(screen, starX, by+s*2+6, 2.5, config.ColorNeonYellow, false)
 	}
+
+	// Equipped item pips
+	for i, it := range u.Items {
+		vector.DrawFilledRect(screen, bx+s*2-7, by+3+float32(i)*6, 4, 4, it.Color, false)
+	}
 }
@@ -0,0 +1,155 @@
+package entity
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"neonsigil/internal/config"
+	"neonsigil/internal/data"
+)
+
+// maxZoneSlow caps the combined slow of overlapping zones
+const maxZoneSlow = 0.8
+
+// ZoneFx holds the ground zone effects currently applied to an enemy
+type ZoneFx struct {
+	Slow       float64 // movement speed reduction (strongest zone wins)
+	Vulnerable float64 // extra damage taken (overlapping zones add up)
+}
+
+// Zone is a temporary ground effect centered on a path tile
+type Zone struct {
+	Def       *data.ZoneDef
+	Tile      config.Pos
+	Pos       config.FPos // pixel center
+	Timer     float64     // remaining duration
+	TickTimer float64     // DOT: time until the next damage tick
+	Stacks    int
+	Power     float64 // DOT damage per tick per stack
+	Owner     *Unit   // credited with damage statistics (nil for barriers and consumables)
+	Alive     bool
+}
+
+// NewZone creates a zone on the given tile. Unit-made damage zones scale
+// with the owner's ATK; all others use Def.Value as flat damage.
+func NewZone(def *data.ZoneDef, tile config.Pos, owner *Unit) *Zone {
+	power := def.Value
+	if owner != nil {
+		power *= owner.EffectiveATK()
+	}
+	return &Zone{
+		Def:  def,
+		Tile: tile,
+		Pos: config.FPos{
+			X: float64(config.BoardOffsetX+tile.X*config.TileSize) + float64(config.TileSize)/2,
+			Y: float64(config.BoardOffsetY+tile.Y*config.TileSize) + float64(config.TileSize)/2,
+		},
+		Timer:     def.Duration,
+		TickTimer: def.TickRate,
+		Stacks:    1,
+		Power:     power,
+		Owner:     owner,
+		Alive:     true,
+	}
+}
+
+// Refresh applies the stacking rule for a new zone landing on this one
+func (z *Zone) Refresh(other *Zone) {
+	z.Timer = z.Def.Duration
+	z.Power = math.Max(z.Power, other.Power)
+	if z.Def.Stacking == config.StackIntensify && z.Stacks < max(z.Def.MaxStacks, 1) {
+		z.Stacks++
+	}
+	if z.Owner == nil {
+		z.Owner = other.Owner
+	}
+}
+
+// Covers reports whether the enemy is standing in the zone
+func (z *Zone) Covers(e *Enemy) bool {
+	if !e.Alive || e.Reached || (e.IsAir() && !z.Def.HitsAir) {
+		return false
+	}
+	dx := e.Pos.X - z.Pos.X
+	dy := e.Pos.Y - z.Pos.Y
+	r := z.Def.Radius * float64(config.TileSize)
+	return dx*dx+dy*dy <= r*r
+}
+
+// Update runs one frame of the zone: damage ticks for DOT zones, the slow
+// and vulnerability effects on every enemy inside for the others
+func (z *Zone) Update(enemies []*Enemy) {
+	if !z.Alive {
+		return
+	}
+	z.Timer -= 1.0 / 60.0
+	if z.Timer <= 0 {
+		z.Alive = false
+		return
+	}
+
+	tick := false
+	if z.Def.Kind == config.ZoneDamage {
+		z.TickTimer -= 1.0 / 60.0
+		if z.TickTimer <= 0 {
+			z.TickTimer += z.Def.TickRate
+			tick = true
+		}
+	}
+
+	strength := z.Def.Value * float64(z.Stacks)
+	for _, e := range enemies {
+		if !z.Covers(e) {
+			continue
+		}
+		switch z.Def.Kind {
+		case config.ZoneDamage:
+			if tick {
+				dealt, overkill := e.TakeDamage(z.Power*float64(z.Stacks), config.DamageMagic)
+				if z.Owner != nil {
+					z.Owner.Stats.Dealt += dealt
+					z.Owner.Stats.Overkill += overkill
+				}
+			}
+		case config.ZoneSlow:
+			e.Zone.Slow = math.Min(math.Max(e.Zone.Slow, strength), maxZoneSlow)
+		case config.ZoneVulnerable:
+			e.Zone.Vulnerable += strength
+		}
+	}
+}
+
+// Draw renders the zone on the ground; drawn beneath all enemies
+func (z *Zone) Draw(screen *ebiten.Image, tick int) {
+	if !z.Alive {
+		return
+	}
+	x := float32(z.Pos.X)
+	y := float32(z.Pos.Y)
+	r := float32(z.Def.Radius * float64(config.TileSize))
+	c := z.Def.Color
+
+	// Fade out over the last second, denser with more stacks
+	fade := math.Min(z.Timer, 1)
+	pulse := math.Sin(float64(tick%60)/60.0*math.Pi*2)*0.2 + 0.8
+	fill := uint8(math.Min(30+15*float64(z.Stacks), 90) * fade)
+	vector.DrawFilledCircle(screen, x, y, r, color.RGBA{c.R, c.G, c.B, fill}, false)
+	vector.StrokeCircle(screen, x, y, r, 1.5, color.RGBA{c.R, c.G, c.B, uint8(160 * pulse * fade)}, false)
+
+	switch z.Def.Kind {
+	case config.ZoneDamage:
+		// Bubbles rising from the pool
+		for i := 0; i < z.Stacks+1; i++ {
+			a := float64(i)*2.1 + float64(tick)*0.03
+			bx := x + float32(math.Cos(a))*r*0.5
+			by := y + float32(math.Sin(a*1.3))*r*0.5
+			vector.DrawFilledCircle(screen, bx, by, 2, color.RGBA{c.R, c.G, c.B, uint8(180 * fade)}, false)
+		}
+	case config.ZoneVulnerable:
+		// Inner sigil ring
+		vector.StrokeCircle(screen, x, y, r*0.6, 1, color.RGBA{c.R, c.G, c.B, uint8(100 * pulse * fade)}, false)
+	}
+}
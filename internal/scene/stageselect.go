@@ -49,8 +49,8 @@ func (s *StageSelectState) Update() int {
 	mx, my := ebiten.CursorPosition()
 	if mx >= 340 && mx <= 940 {
 		for i := range data.Stages {
-			itemY := 140 + i*50
-			if my >= itemY && my < itemY+44 {
+			itemY := 100 + i*42
+			if my >= itemY && my < itemY+40 {
 				s.Selected = i
 				if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
 					return s.Selected
@@ -78,10 +78,10 @@ func (s *StageSelectState) Draw(screen *ebiten.Image) {
 
 	// Stage list
 	for i, stage := range data.Stages {
-		y := float64(140 + i*50)
+		y := float64(100 + i*42)
 		x := 340.0
 		w := 600.0
-		h := 44.0
+		h := 40.0
 
 		isSelected := i == s.Selected
 
@@ -0,0 +1,67 @@
+package shop
+
+import "neonsigil/internal/config"
+
+// Transaction is a single recorded gold movement
+type Transaction struct {
+	Amount int // positive = income, negative = expense
+	Reason config.GoldReason
+	Tick   int
+	Wave   int // 1-based wave the movement belongs to (its preparation and combat)
+}
+
+// WaveSummary totals the income and expenses of one wave
+type WaveSummary struct {
+	Wave    int
+	Income  int
+	Expense int // positive number
+}
+
+// Net returns income minus expenses
+func (w WaveSummary) Net() int {
+	return w.Income - w.Expense
+}
+
+// Ledger records every gold movement. Tick and Wave are kept current by the
+// battle and stamped onto each new entry.
+type Ledger struct {
+	Entries []Transaction
+	Tick    int
+	Wave    int
+}
+
+// Record appends a transaction
+func (l *Ledger) Record(amount int, reason config.GoldReason) {
+	if amount == 0 {
+		return
+	}
+	l.Entries = append(l.Entries, Transaction{Amount: amount, Reason: reason, Tick: l.Tick, Wave: l.Wave})
+}
+
+// Summaries returns per-wave income and expense totals in wave order
+func (l *Ledger) Summaries() []WaveSummary {
+	var out []WaveSummary
+	for _, t := range l.Entries {
+		if len(out) == 0 || out[len(out)-1].Wave != t.Wave {
+			out = append(out, WaveSummary{Wave: t.Wave})
+		}
+		w := &out[len(out)-1]
+		if t.Amount > 0 {
+			w.Income += t.Amount
+		} else {
+			w.Expense -= t.Amount
+		}
+	}
+	return out
+}
+
+// ByReason totals the given wave's transactions per reason
+func (l *Ledger) ByReason(wave int) map[config.GoldReason]int {
+	totals := make(map[config.GoldReason]int)
+	for _, t := range l.Entries {
+		if t.Wave == wave {
+			totals[t.Reason] += t.Amount
+		}
+	}
+	return totals
+}
@@ -0,0 +1,92 @@
+package shop
+
+import (
+	"reflect"
+	"testing"
+
+	"neonsigil/internal/config"
+)
+
+// record stamps a transaction onto the given wave
+func record(l *Ledger, wave, amount int, reason config.GoldReason) {
+	l.Wave = wave
+	l.Record(amount, reason)
+}
+
+func TestLedgerRecord(t *testing.T) {
+	l := &Ledger{Tick: 42, Wave: 3}
+	l.Record(5, config.GoldKill)
+	l.Record(0, config.GoldKill)
+	l.Record(-2, config.GoldReroll)
+
+	want := []Transaction{
+		{Amount: 5, Reason: config.GoldKill, Tick: 42, Wave: 3},
+		{Amount: -2, Reason: config.GoldReroll, Tick: 42, Wave: 3},
+	}
+	if !reflect.DeepEqual(l.Entries, want) {
+		t.Fatalf("Entries = %+v, want %+v (zero amounts are skipped)", l.Entries, want)
+	}
+}
+
+func TestLedgerSummaries(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries func(l *Ledger)
+		want    []WaveSummary
+	}{
+		{"empty", func(l *Ledger) {}, nil},
+		{"one wave", func(l *Ledger) {
+			record(l, 1, 3, config.GoldKill)
+			record(l, 1, -2, config.GoldReroll)
+			record(l, 1, 4, config.GoldWave)
+		}, []WaveSummary{{Wave: 1, Income: 7, Expense: 2}}},
+		{"waves in order", func(l *Ledger) {
+			record(l, 1, -3, config.GoldBuyUnit)
+			record(l, 2, 5, config.GoldWave)
+			record(l, 2, -1, config.GoldBuyXP)
+			record(l, 3, 2, config.GoldSell)
+		}, []WaveSummary{
+			{Wave: 1, Expense: 3},
+			{Wave: 2, Income: 5, Expense: 1},
+			{Wave: 3, Income: 2},
+		}},
+	}
+	for _, tt := range tests {
+		l := &Ledger{}
+		tt.entries(l)
+		if got := l.Summaries(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Summaries = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestWaveSummaryNet(t *testing.T) {
+	w := WaveSummary{Income: 9, Expense: 12}
+	if got := w.Net(); got != -3 {
+		t.Fatalf("Net = %d, want -3", got)
+	}
+}
+
+func TestLedgerByReason(t *testing.T) {
+	l := &Ledger{}
+	record(l, 1, 1, config.GoldKill)
+	record(l, 2, 1, config.GoldKill)
+	record(l, 2, 1, config.GoldKill)
+	record(l, 2, -2, config.GoldReroll)
+	record(l, 2, -2, config.GoldReroll)
+	record(l, 2, 6, config.GoldWave)
+
+	tests := []struct {
+		wave int
+		want map[config.GoldReason]int
+	}{
+		{1, map[config.GoldReason]int{config.GoldKill: 1}},
+		{2, map[config.GoldReason]int{config.GoldKill: 2, config.GoldReroll: -4, config.GoldWave: 6}},
+		{3, map[config.GoldReason]int{}},
+	}
+	for _, tt := range tests {
+		if got := l.ByReason(tt.wave); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ByReason(%d) = %v, want %v", tt.wave, got, tt.want)
+		}
+	}
+}
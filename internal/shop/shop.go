@@ -12,11 +12,15 @@ import (
 type Shop struct {
 	Slots     [config.ShopSlots]*data.UnitDef // nil = empty slot
 	Gold      int
+	Ledger    Ledger
 	Level     int
 	XP        int
 	DeployCap int
 	Rules     data.ShopRules
 	Rng       *rand.Rand
+	Pool      map[string]int         // unit ID -> copies left in the shared pool
+	Locked    bool                   // keep every slot through refreshes
+	Frozen    [config.ShopSlots]bool // keep individual slots through refreshes and rerolls
 }
 
 // NewShop creates a new shop for the given stage
@@ -27,16 +31,89 @@ func NewShop(stage *data.StageDef, rng *rand.Rand) *Shop {
 		DeployCap: stage.DeployCapBase,
 		Rules:     stage.ShopRules,
 		Rng:       rng,
+		Pool:      make(map[string]int),
+		Ledger:    Ledger{Wave: 1},
+	}
+	for _, def := range data.UnitDefs {
+		s.Pool[def.ID] = data.PoolCopies[def.Cost]
 	}
 	s.Refresh()
 	return s
 }
 
-// Refresh fills all shop slots with random units
+// Refresh fills all shop slots with random units. Units still on offer
+// go back to the pool before the new ones are drawn. A locked shop keeps
+// every slot, and frozen slots are always kept.
 func (s *Shop) Refresh() {
+	if s.Locked {
+		return
+	}
+	for i := 0; i < config.ShopSlots; i++ {
+		if s.Slots[i] != nil && !s.Frozen[i] {
+			s.Pool[s.Slots[i].ID]++
+			s.Slots[i] = nil
+		}
+	}
 	for i := 0; i < config.ShopSlots; i++ {
-		s.Slots[i] = s.rollUnit()
+		if !s.Frozen[i] {
+			s.Slots[i] = s.rollUnit()
+		}
+	}
+}
+
+// ToggleLock locks or unlocks the whole shop
+func (s *Shop) ToggleLock() {
+	s.Locked = !s.Locked
+}
+
+// ToggleFreeze freezes or unfreezes a single occupied slot
+func (s *Shop) ToggleFreeze(slot int) {
+	if slot < 0 || slot >= config.ShopSlots || s.Slots[slot] == nil {
+		return
+	}
+	s.Frozen[slot] = !s.Frozen[slot]
+}
+
+// Remaining returns how many copies of a unit are left in the pool
+func (s *Shop) Remaining(def *data.UnitDef) int {
+	return s.Pool[def.ID]
+}
+
+// ReturnToPool puts a sold unit's copies back. A fused unit holds the
+// copies of every unit it consumed, so a 2-star returns three.
+func (s *Shop) ReturnToPool(u *entity.Unit) {
+	copies := 1
+	for i := 1; i < u.Star; i++ {
+		copies *= 3
+	}
+	s.Pool[u.Def.ID] += copies
+}
+
+// copiesForCost returns the total copies left across a cost tier
+func (s *Shop) copiesForCost(cost int) int {
+	total := 0
+	for _, def := range data.GetUnitsForCost(cost) {
+		total += s.Pool[def.ID]
+	}
+	return total
+}
+
+// drawFromPool picks a unit of the given cost weighted by remaining copies
+// and takes it out of the pool
+func (s *Shop) drawFromPool(cost int) *data.UnitDef {
+	total := s.copiesForCost(cost)
+	if total == 0 {
+		return nil
+	}
+	roll := s.Rng.Intn(total)
+	for _, def := range data.GetUnitsForCost(cost) {
+		roll -= s.Pool[def.ID]
+		if roll < 0 {
+			s.Pool[def.ID]--
+			return def
+		}
 	}
+	return nil
 }
 
 func (s *Shop) rollUnit() *data.UnitDef {
@@ -46,10 +123,10 @@ func (s *Shop) rollUnit() *data.UnitDef {
 		weights = data.ShopWeights[1]
 	}
 
-	// Filter by allowed costs
+	// Filter by allowed costs with copies left in the pool
 	allowedWeights := make([]float64, len(weights))
 	for _, c := range s.Rules.AllowedCosts {
-		if c-1 < len(weights) {
+		if c-1 < len(weights) && s.copiesForCost(c) > 0 {
 			allowedWeights[c-1] = weights[c-1]
 		}
 	}
@@ -60,10 +137,11 @@ func (s *Shop) rollUnit() *data.UnitDef {
 		total += w
 	}
 	if total == 0 {
-		// Fallback: all 1-cost
-		units := data.GetUnitsForCost(1)
-		if len(units) > 0 {
-			return units[s.Rng.Intn(len(units))]
+		// Fallback: any allowed cost that still has copies
+		for _, c := range s.Rules.AllowedCosts {
+			if def := s.drawFromPool(c); def != nil {
+				return def
+			}
 		}
 		return nil
 	}
@@ -79,14 +157,7 @@ func (s *Shop) rollUnit() *data.UnitDef {
 		}
 	}
 
-	units := data.GetUnitsForCost(selectedCost)
-	if len(units) == 0 {
-		units = data.GetUnitsForCost(1)
-	}
-	if len(units) == 0 {
-		return nil
-	}
-	return units[s.Rng.Intn(len(units))]
+	return s.drawFromPool(selectedCost)
 }
 
 // CanBuy checks if the player can afford the unit in the given slot
@@ -106,14 +177,15 @@ func (s *Shop) Buy(slot int) *entity.Unit {
 		return nil
 	}
 	def := s.Slots[slot]
-	s.Gold -= def.Cost
+	s.Spend(def.Cost, config.GoldBuyUnit)
 	s.Slots[slot] = nil
+	s.Frozen[slot] = false
 	return entity.NewUnit(def)
 }
 
-// CanReroll checks if the player can afford a reroll
+// CanReroll checks if the player can afford a reroll and the shop is unlocked
 func (s *Shop) CanReroll() bool {
-	return s.Rules.RerollEnabled && s.Gold >= config.RerollCost
+	return s.Rules.RerollEnabled && !s.Locked && s.Gold >= config.RerollCost
 }
 
 // Reroll refreshes all shop slots
@@ -121,43 +193,143 @@ func (s *Shop) Reroll() bool {
 	if !s.CanReroll() {
 		return false
 	}
-	s.Gold -= config.RerollCost
+	s.Spend(config.RerollCost, config.GoldReroll)
 	s.Refresh()
 	return true
 }
 
-// LevelUpCost returns the cost to level up
-func (s *Shop) LevelUpCost() int {
-	return 4 + s.Level*2
+// XPNeeded returns the XP required to advance from the current level, or 0 at max level
+func (s *Shop) XPNeeded() int {
+	if s.Level >= config.MaxShopLevel {
+		return 0
+	}
+	curve := s.Rules.XPCurve
+	if curve == nil {
+		curve = data.XPCurve
+	}
+	if s.Level < len(curve) {
+		return curve[s.Level]
+	}
+	return curve[len(curve)-1]
+}
+
+// XPBuyCost returns the gold cost of one XP purchase
+func (s *Shop) XPBuyCost() int {
+	if s.Rules.XPBuyCost > 0 {
+		return s.Rules.XPBuyCost
+	}
+	return config.XPBuyCost
+}
+
+// XPBuyAmount returns the XP granted by one purchase
+func (s *Shop) XPBuyAmount() int {
+	if s.Rules.XPBuyAmount > 0 {
+		return s.Rules.XPBuyAmount
+	}
+	return config.XPBuyAmount
+}
+
+// CanBuyXP checks if the player can buy experience
+func (s *Shop) CanBuyXP() bool {
+	return s.Rules.LevelUpEnabled && s.Gold >= s.XPBuyCost() && s.Level < config.MaxShopLevel
+}
+
+// BuyXP buys one chunk of experience
+func (s *Shop) BuyXP() bool {
+	if !s.CanBuyXP() {
+		return false
+	}
+	s.Spend(s.XPBuyCost(), config.GoldBuyXP)
+	s.AddXP(s.XPBuyAmount())
+	return true
+}
+
+// GrantWaveXP gives the passive experience earned by clearing a wave
+func (s *Shop) GrantWaveXP() {
+	if !s.Rules.LevelUpEnabled {
+		return
+	}
+	xp := s.Rules.XPPerWave
+	if xp <= 0 {
+		xp = config.XPPerWave
+	}
+	s.AddXP(xp)
+}
+
+// AddXP adds experience, levelling up (and raising the deploy cap) as
+// thresholds are crossed. Excess XP carries over.
+func (s *Shop) AddXP(amount int) {
+	if s.Level >= config.MaxShopLevel {
+		return
+	}
+	s.XP += amount
+	for s.Level < config.MaxShopLevel && s.XP >= s.XPNeeded() {
+		s.XP -= s.XPNeeded()
+		s.Level++
+		s.DeployCap++
+	}
+	if s.Level >= config.MaxShopLevel {
+		s.XP = 0
+	}
+}
+
+// CanBuyComponent checks if the player can afford an item component
+func (s *Shop) CanBuyComponent() bool {
+	return s.Gold >= config.ComponentCost
 }
 
-// CanLevelUp checks if the player can level up
-func (s *Shop) CanLevelUp() bool {
-	return s.Rules.LevelUpEnabled && s.Gold >= s.LevelUpCost() && s.Level < 6
+// BuyComponent purchases a random item component
+func (s *Shop) BuyComponent() *data.ItemDef {
+	if !s.CanBuyComponent() {
+		return nil
+	}
+	comps := data.GetComponents()
+	if len(comps) == 0 {
+		return nil
+	}
+	s.Spend(config.ComponentCost, config.GoldBuyPart)
+	return comps[s.Rng.Intn(len(comps))]
 }
 
-// LevelUp increases the shop level
-func (s *Shop) LevelUp() bool {
-	if !s.CanLevelUp() {
+// CanBuyTrap checks if the player can afford a trap
+func (s *Shop) CanBuyTrap(def *data.TrapDef) bool {
+	return def != nil && s.Gold >= def.Cost
+}
+
+// BuyTrap pays for a trap; returns false when it cannot be afforded
+func (s *Shop) BuyTrap(def *data.TrapDef) bool {
+	if !s.CanBuyTrap(def) {
 		return false
 	}
-	s.Gold -= s.LevelUpCost()
-	s.Level++
-	s.DeployCap++
+	s.Spend(def.Cost, config.GoldBuyTrap)
 	return true
 }
 
-// SellUnit sells a unit and refunds gold
-func (s *Shop) SellUnit(u *entity.Unit) int {
-	refund := max(1, u.Def.Cost/2)
+// SellPrice returns the gold refunded for selling a unit. Both the sell
+// button and SellUnit use it.
+func SellPrice(u *entity.Unit) int {
 	if u.Star >= 2 {
-		refund = u.Def.Cost * u.Star
+		return u.Def.Cost * u.Star
 	}
-	s.Gold += refund
+	return max(1, u.Def.Cost/2)
+}
+
+// SellUnit sells a unit, refunds gold and returns its copies to the pool
+func (s *Shop) SellUnit(u *entity.Unit) int {
+	refund := SellPrice(u)
+	s.AddGold(refund, config.GoldSell)
+	s.ReturnToPool(u)
 	return refund
 }
 
-// AddGold adds gold (from kills, wave bonus, etc.)
-func (s *Shop) AddGold(amount int) {
+// AddGold adds gold (from kills, wave income, sales) and records it
+func (s *Shop) AddGold(amount int, reason config.GoldReason) {
 	s.Gold += amount
+	s.Ledger.Record(amount, reason)
+}
+
+// Spend removes gold and records it
+func (s *Shop) Spend(amount int, reason config.GoldReason) {
+	s.Gold -= amount
+	s.Ledger.Record(-amount, reason)
 }
@@ -43,6 +43,11 @@ func FontRegular(size float64) *text.GoTextFace {
 	return &text.GoTextFace{Source: fontSourceRegular, Size: size}
 }
 
+// MeasureText returns the width and height of the given text
+func MeasureText(str string, face *text.GoTextFace) (float64, float64) {
+	return text.Measure(str, face, 0)
+}
+
 // DrawText draws text at the given position
 func DrawText(screen *ebiten.Image, str string, face *text.GoTextFace, x, y float64, clr color.RGBA) {
 	op := &text.DrawOptions{}
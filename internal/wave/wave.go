@@ -1,21 +1,34 @@
 package wave
 
 import (
+	"math"
+
 	"neonsigil/internal/board"
+	"neonsigil/internal/config"
 	"neonsigil/internal/data"
 	"neonsigil/internal/entity"
 )
 
+// burstGap is the time between enemies within a BURST formation
+const burstGap = 0.15
+
+// clusterSpacing is the gap in pixels between enemies of a CLUSTER formation
+const clusterSpacing = 10.0
+
 // WaveManager handles wave spawning
 type WaveManager struct {
 	Stage          *data.StageDef
 	Board          *board.Board
 	CurrentWave    int
-	GroupTimers    []float64 // timer for each group in current wave
-	GroupCounts    []int     // how many spawned per group
+	GroupTimers    []float64         // timer for each group in current wave
+	GroupCounts    []int             // how many spawned per group
+	GroupReleased  []bool            // group has started (its After group finished)
+	GroupBurst     []int             // BURST: enemies spawned in the current burst
+	GroupEnemies   [][]*entity.Enemy // enemies spawned per group, for After sequencing
 	WaveActive     bool
 	AllDone        bool
 	SpawnedEnemies []*entity.Enemy
+	SpawnCount     int // enemies spawned this wave, numbers them for the route log
 }
 
 // NewWaveManager creates a new wave manager
@@ -41,10 +54,58 @@ func (wm *WaveManager) StartWave() {
 	}
 
 	wave := wm.Stage.Waves[wm.CurrentWave]
-	wm.GroupTimers = make([]float64, len(wave.Groups))
-	wm.GroupCounts = make([]int, len(wave.Groups))
+	n := len(wave.Groups)
+	wm.GroupTimers = make([]float64, n)
+	wm.GroupCounts = make([]int, n)
+	wm.GroupReleased = make([]bool, n)
+	wm.GroupBurst = make([]int, n)
+	wm.GroupEnemies = make([][]*entity.Enemy, n)
 	wm.WaveActive = true
 	wm.SpawnedEnemies = nil
+	wm.SpawnCount = 0
+}
+
+// Multipliers returns the enemy HP and speed multipliers of a wave,
+// combining the stage's with the wave's own
+func (wm *WaveManager) Multipliers(wave data.WaveDef) (hp, spd float64) {
+	return wm.Stage.EnemyHPMul * orOne(wave.HPMul), wm.Stage.EnemySpdMul * orOne(wave.SpdMul)
+}
+
+// orOne treats a zero multiplier as unset
+func orOne(v float64) float64 {
+	if v <= 0 {
+		return 1
+	}
+	return v
+}
+
+// dependency returns the 0-based index of the group g waits for, or -1
+func dependency(g data.WaveGroup, self, groups int) int {
+	dep := g.After - 1
+	if dep < 0 || dep >= groups || dep == self {
+		return -1
+	}
+	return dep
+}
+
+// groupCleared reports whether a group has spawned everything and all of
+// its enemies are dead or through
+func (wm *WaveManager) groupCleared(i int, group data.WaveGroup) bool {
+	if wm.GroupCounts[i] < group.Count {
+		return false
+	}
+	for _, e := range wm.GroupEnemies[i] {
+		if e.Alive && !e.Reached {
+			return false
+		}
+	}
+	return true
+}
+
+// release starts a group; its first spawn waits for the group's Delay
+func (wm *WaveManager) release(i int, group data.WaveGroup) {
+	wm.GroupReleased[i] = true
+	wm.GroupTimers[i] = group.Delay
 }
 
 // Update spawns enemies and checks wave completion
@@ -58,6 +119,14 @@ func (wm *WaveManager) Update() []*entity.Enemy {
 
 	allSpawned := true
 	for i, group := range wave.Groups {
+		if !wm.GroupReleased[i] {
+			allSpawned = false
+			dep := dependency(group, i, len(wave.Groups))
+			if dep >= 0 && !wm.groupCleared(dep, wave.Groups[dep]) {
+				continue
+			}
+			wm.release(i, group)
+		}
 		if wm.GroupCounts[i] >= group.Count {
 			continue
 		}
@@ -65,18 +134,13 @@ func (wm *WaveManager) Update() []*entity.Enemy {
 
 		wm.GroupTimers[i] -= 1.0 / 60.0
 		if wm.GroupTimers[i] <= 0 {
-			// Spawn one enemy
-			def := data.EnemyDefs[group.Enemy]
-			if def != nil {
-				e := entity.NewEnemy(def, group.PathID, wm.Stage.EnemyHPMul, wm.Stage.EnemySpdMul, wm.Board)
-				newEnemies = append(newEnemies, e)
-				wm.SpawnedEnemies = append(wm.SpawnedEnemies, e)
-			}
-			wm.GroupCounts[i]++
-			wm.GroupTimers[i] = group.Interval
+			newEnemies = append(newEnemies, wm.spawnFormation(i, wave)...)
 		}
 	}
 
+	// Groups waiting on each other in a loop would never start: let them go
+	wm.breakCycles(wave)
+
 	// Check if wave is complete (all spawned and all dead/reached)
 	if allSpawned {
 		allDead := true
@@ -98,6 +162,106 @@ func (wm *WaveManager) Update() []*entity.Enemy {
 	return newEnemies
 }
 
+// spawnFormation lets out the next enemies of a group according to its
+// formation and resets the group timer
+func (wm *WaveManager) spawnFormation(i int, wave data.WaveDef) []*entity.Enemy {
+	group := wave.Groups[i]
+	size := group.Size
+	if size <= 0 {
+		size = config.DefaultFormationSize
+	}
+
+	var spawned []*entity.Enemy
+	switch group.Formation {
+	case config.FormCluster:
+		n := min(size, group.Count-wm.GroupCounts[i])
+		for k := 0; k < n; k++ {
+			if e := wm.spawnOne(i, wave, k); e != nil {
+				spawned = append(spawned, e)
+			}
+		}
+		wm.GroupTimers[i] = group.Interval
+	case config.FormBurst:
+		if e := wm.spawnOne(i, wave, 0); e != nil {
+			spawned = append(spawned, e)
+		}
+		wm.GroupBurst[i]++
+		wm.GroupTimers[i] = burstGap
+		if wm.GroupBurst[i] >= size {
+			wm.GroupBurst[i] = 0
+			wm.GroupTimers[i] = group.Interval
+		}
+	default:
+		if e := wm.spawnOne(i, wave, 0); e != nil {
+			spawned = append(spawned, e)
+		}
+		wm.GroupTimers[i] = group.Interval
+	}
+	return spawned
+}
+
+// spawnOne creates one enemy of group i, slot places it that many cluster
+// gaps behind the spawn point
+func (wm *WaveManager) spawnOne(i int, wave data.WaveDef, slot int) *entity.Enemy {
+	group := wave.Groups[i]
+	wm.GroupCounts[i]++
+	def := data.EnemyDefs[group.Enemy]
+	if def == nil {
+		return nil
+	}
+	hpMul, spdMul := wm.Multipliers(wave)
+	e := entity.NewEnemy(def, group.PathID, hpMul, spdMul, wm.Board)
+	if pd := wm.Board.PathByID(group.PathID); slot > 0 && pd != nil && len(pd.Waypoints) > 1 {
+		dx := float64(pd.Waypoints[1].X - pd.Waypoints[0].X)
+		dy := float64(pd.Waypoints[1].Y - pd.Waypoints[0].Y)
+		if l := math.Sqrt(dx*dx + dy*dy); l > 0 {
+			e.Pos.X -= dx / l * clusterSpacing * float64(slot)
+			e.Pos.Y -= dy / l * clusterSpacing * float64(slot)
+		}
+	}
+	e.Serial = wm.SpawnCount
+	wm.SpawnCount++
+	wm.GroupEnemies[i] = append(wm.GroupEnemies[i], e)
+	wm.SpawnedEnemies = append(wm.SpawnedEnemies, e)
+	return e
+}
+
+// breakCycles releases the waiting groups when every one of them waits on
+// another group that has not started either
+func (wm *WaveManager) breakCycles(wave data.WaveDef) {
+	waiting := 0
+	for i, group := range wave.Groups {
+		if wm.GroupReleased[i] {
+			continue
+		}
+		waiting++
+		if dep := dependency(group, i, len(wave.Groups)); dep < 0 || wm.GroupReleased[dep] {
+			return
+		}
+	}
+	if waiting == 0 {
+		return
+	}
+	for i, group := range wave.Groups {
+		if !wm.GroupReleased[i] {
+			wm.release(i, group)
+		}
+	}
+}
+
+// Track registers enemies spawned outside the wave script (summons) so the
+// wave does not end while they are still alive
+func (wm *WaveManager) Track(enemies ...*entity.Enemy) {
+	if !wm.WaveActive {
+		return
+	}
+	for _, e := range enemies {
+		e.Serial = wm.SpawnCount
+		wm.SpawnCount++
+	}
+	wm.SpawnedEnemies = append(wm.SpawnedEnemies, enemies...)
+}
+
 // IsWaveActive returns whether a wave is currently active
 func (wm *WaveManager) IsWaveActive() bool {
 	return wm.WaveActive
@@ -112,7 +276,7 @@ func (wm *WaveManager) WaveCompleteCheck(enemies []*entity.Enemy) bool {
 	wave := wm.Stage.Waves[wm.CurrentWave]
 	allSpawned := true
 	for i, group := range wave.Groups {
-		if wm.GroupCounts[i] < group.Count {
+		if !wm.GroupReleased[i] || wm.GroupCounts[i] < group.Count {
 			allSpawned = false
 			break
 		}
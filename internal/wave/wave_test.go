@@ -0,0 +1,199 @@
+package wave
+
+import (
+	"reflect"
+	"testing"
+
+	"neonsigil/internal/board"
+	"neonsigil/internal/config"
+	"neonsigil/internal/data"
+)
+
+// newManager starts a single-wave stage on a straight path along the top row
+func newManager(wave data.WaveDef) *WaveManager {
+	stage := &data.StageDef{
+		Paths: []data.PathDef{{ID: "P0", Waypoints: []config.Pos{
+			{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 2, Y: 0}, {X: 3, Y: 0},
+		}}},
+		Waves:      []data.WaveDef{wave},
+		EnemyHPMul: 1, EnemySpdMul: 1,
+	}
+	wm := NewWaveManager(stage, board.NewBoard(stage))
+	wm.StartWave()
+	return wm
+}
+
+// runner is a plain group on the test path
+func runner(count int, interval float64) data.WaveGroup {
+	return data.WaveGroup{Enemy: config.EnemyRunner, Count: count, Interval: interval, PathID: "P0"}
+}
+
+// run steps the manager frame by frame and returns, per group, the frame
+// each enemy spawned on. From frame killFrom on every spawned enemy dies
+// at the end of the frame (-1 = never).
+func run(wm *WaveManager, frames, killFrom int) [][]int {
+	spawns := make([][]int, len(wm.GroupEnemies))
+	for f := 0; f < frames && wm.WaveActive; f++ {
+		wm.Update()
+		for i, enemies := range wm.GroupEnemies {
+			for len(spawns[i]) < len(enemies) {
+				spawns[i] = append(spawns[i], f)
+			}
+		}
+		if killFrom >= 0 && f >= killFrom {
+			for _, e := range wm.SpawnedEnemies {
+				e.Alive = false
+			}
+		}
+	}
+	return spawns
+}
+
+// near allows one frame of float drift on timers
+func near(got, want []int) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if d := got[i] - want[i]; d < -1 || d > 1 {
+			return false
+		}
+	}
+	return true
+}
+
+func TestAfterChain(t *testing.T) {
+	b := runner(1, 0)
+	b.After, b.Delay = 1, 1
+	c := runner(1, 0)
+	c.After = 2
+	wm := newManager(data.WaveDef{Groups: []data.WaveGroup{runner(2, 0.5), b, c}})
+
+	// Group 1 lives until frame 100, so group 2 waits for it, then its Delay
+	spawns := run(wm, 400, 100)
+	want := [][]int{{0, 30}, {160}, {161}}
+	for i := range want {
+		if !near(spawns[i], want[i]) {
+			t.Errorf("group %d spawned on frames %v, want about %v", i+1, spawns[i], want[i])
+		}
+	}
+	if wm.WaveActive || wm.CurrentWave != 1 {
+		t.Errorf("wave still active after every group was cleared")
+	}
+}
+
+func TestAfterWaitsForLiveEnemies(t *testing.T) {
+	b := runner(1, 0)
+	b.After = 1
+	wm := newManager(data.WaveDef{Groups: []data.WaveGroup{runner(1, 0), b}})
+
+	spawns := run(wm, 300, -1)
+	if len(spawns[1]) != 0 || wm.GroupReleased[1] {
+		t.Fatalf("group 2 started while group 1 was still alive (spawns %v)", spawns[1])
+	}
+	if wm.WaveCompleteCheck(wm.SpawnedEnemies) {
+		t.Fatalf("wave reported complete with a group never released")
+	}
+}
+
+func TestCycleRelease(t *testing.T) {
+	tests := []struct {
+		name   string
+		after  []int
+		frames []int
+	}{
+		{"pair", []int{2, 1}, []int{1, 1}},
+		{"pair with a waiter", []int{2, 1, 1}, []int{1, 1, 1}},
+		{"self", []int{1}, []int{0}},
+		{"out of range", []int{5}, []int{0}},
+	}
+	for _, tt := range tests {
+		groups := make([]data.WaveGroup, len(tt.after))
+		for i, after := range tt.after {
+			groups[i] = runner(1, 0)
+			groups[i].After = after
+		}
+		wm := newManager(data.WaveDef{Groups: groups})
+		spawns := run(wm, 10, -1)
+		for i, want := range tt.frames {
+			if !near(spawns[i], []int{want}) {
+				t.Errorf("%s: group %d spawned on frames %v, want about [%d]", tt.name, i+1, spawns[i], want)
+			}
+		}
+	}
+}
+
+func TestClusterFormation(t *testing.T) {
+	tests := []struct {
+		name  string
+		count int
+		size  int
+		want  []int // enemies per release
+	}{
+		{"full clusters", 6, 3, []int{3, 3}},
+		{"short last cluster", 7, 3, []int{3, 3, 1}},
+		{"default size", 4, 0, []int{config.DefaultFormationSize, 4 - config.DefaultFormationSize}},
+	}
+	for _, tt := range tests {
+		g := runner(tt.count, 1)
+		g.Formation, g.Size = config.FormCluster, tt.size
+		wm := newManager(data.WaveDef{Groups: []data.WaveGroup{g}})
+		spawns := run(wm, 300, -1)
+
+		var got []int
+		for i, f := range spawns[0] {
+			if i == 0 || f != spawns[0][i-1] {
+				got = append(got, 0)
+			}
+			got[len(got)-1]++
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: cluster sizes = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	// A cluster lines up behind the spawn point, against the path direction
+	g := runner(3, 1)
+	g.Formation = config.FormCluster
+	wm := newManager(data.WaveDef{Groups: []data.WaveGroup{g}})
+	run(wm, 1, -1)
+	head := wm.GroupEnemies[0][0].Pos
+	for k, e := range wm.GroupEnemies[0] {
+		if want := head.X - clusterSpacing*float64(k); e.Pos.X != want || e.Pos.Y != head.Y {
+			t.Errorf("cluster slot %d at %+v, want X %.0f Y %.0f", k, e.Pos, want, head.Y)
+		}
+	}
+}
+
+func TestBurstFormation(t *testing.T) {
+	g := runner(6, 2)
+	g.Formation, g.Size = config.FormBurst, 3
+	wm := newManager(data.WaveDef{Groups: []data.WaveGroup{g}})
+
+	// Frames between spawns: burstGap inside a burst, Interval between bursts
+	gap := int(burstGap * 60)
+	spawns := run(wm, 400, -1)
+	var gaps []int
+	for i := 1; i < len(spawns[0]); i++ {
+		gaps = append(gaps, spawns[0][i]-spawns[0][i-1])
+	}
+	want := []int{gap, gap, 120, gap, gap}
+	if spawns[0][0] != 0 || !near(gaps, want) {
+		t.Fatalf("burst spawned on frames %v, want gaps of about %v", spawns[0], want)
+	}
+}
+
+func TestMultipliers(t *testing.T) {
+	wm := newManager(data.WaveDef{Groups: []data.WaveGroup{runner(1, 0)}, HPMul: 2})
+	wm.Stage.EnemyHPMul, wm.Stage.EnemySpdMul = 1.5, 1.2
+
+	hp, spd := wm.Multipliers(wm.Stage.Waves[0])
+	if hp != 3 || spd != 1.2 {
+		t.Fatalf("Multipliers = %v, %v, want 3, 1.2 (unset SpdMul counts as 1)", hp, spd)
+	}
+	run(wm, 1, -1)
+	def := data.EnemyDefs[config.EnemyRunner]
+	if e := wm.GroupEnemies[0][0]; e.MaxHP != def.BaseHP*3 || e.Speed != def.Speed*1.2 {
+		t.Fatalf("spawned with HP %v speed %v, want %v and %v", e.MaxHP, e.Speed, def.BaseHP*3, def.Speed*1.2)
+	}
+}